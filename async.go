@@ -0,0 +1,167 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"context"
+	"sync"
+)
+
+// asyncWriter queues finished entries in a bounded ring and encodes them on
+// a worker goroutine, so slow writers don't delay fx hook execution. When
+// the ring is full, the oldest entry is dropped.
+type asyncWriter struct {
+	mu      sync.Mutex
+	cond    *sync.Cond // signaled when entries are queued or the writer is closed
+	ring    []*entry
+	head, n int
+	busy    bool            // an entry is being encoded
+	closed  bool            // no longer accepting entries
+	waiters []chan struct{} // closed once the queue is drained
+	drops   int             // entries dropped because the ring was full
+	done    chan struct{}   // closed when the worker exits
+}
+
+func newAsyncWriter(size int) *asyncWriter {
+	w := &asyncWriter{
+		ring: make([]*entry, max(size, 1)),
+		done: make(chan struct{}),
+	}
+	w.cond = sync.NewCond(&w.mu)
+	return w
+}
+
+// push queues e and reports whether it was accepted. It is not accepted
+// once the writer is closed.
+func (w *asyncWriter) push(e *entry) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return false
+	}
+	if w.n == len(w.ring) {
+		w.ring[w.head] = nil
+		w.head = (w.head + 1) % len(w.ring)
+		w.n--
+		w.drops++
+	}
+	w.ring[(w.head+w.n)%len(w.ring)] = e
+	w.n++
+	w.cond.Signal()
+	return true
+}
+
+// run encodes queued entries until the writer is closed and drained.
+func (w *asyncWriter) run() {
+	defer close(w.done)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for {
+		for w.n == 0 && !w.closed {
+			w.cond.Wait()
+		}
+		if w.n == 0 {
+			w.notify()
+			return
+		}
+		e := w.ring[w.head]
+		w.ring[w.head] = nil
+		w.head = (w.head + 1) % len(w.ring)
+		w.n--
+		w.busy = true
+
+		w.mu.Unlock()
+		e.encode()
+		e.release()
+		w.mu.Lock()
+
+		w.busy = false
+		if w.n == 0 {
+			w.notify()
+		}
+	}
+}
+
+// notify releases the flush waiters. w.mu must be held.
+func (w *asyncWriter) notify() {
+	for _, ch := range w.waiters {
+		close(ch)
+	}
+	w.waiters = nil
+}
+
+// flush waits until the queued entries have been encoded or ctx is done.
+func (w *asyncWriter) flush(ctx context.Context) error {
+	w.mu.Lock()
+	if w.n == 0 && !w.busy {
+		w.mu.Unlock()
+		return nil
+	}
+	ch := make(chan struct{})
+	w.waiters = append(w.waiters, ch)
+	w.mu.Unlock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close stops accepting entries and waits for the worker to drain the queue.
+func (w *asyncWriter) close() {
+	w.mu.Lock()
+	w.closed = true
+	w.cond.Signal()
+	w.mu.Unlock()
+	<-w.done
+}
+
+// dropped returns the number of entries dropped so far.
+func (w *asyncWriter) dropped() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.drops
+}
+
+// Flush writes any record held back by deduplication and, in async mode,
+// waits until the queued records have been written or ctx is done, in which
+// case it returns ctx.Err().
+func (l *Logger) Flush(ctx context.Context) error {
+	l.mu.Lock()
+	l.flush()
+	l.mu.Unlock()
+
+	if l.async == nil {
+		return nil
+	}
+	return l.async.flush(ctx)
+}
+
+// Close flushes the Logger and, in async mode, stops its worker goroutine
+// once every queued record has been written. Records logged after Close are
+// written synchronously. It ends the StreamHandler streams. For a Logger
+// created by NewNonBlocking, Close also flushes and closes the diode writer,
+// returning its error.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	l.flush()
+	l.reportDropped()
+	l.mu.Unlock()
+
+	if l.async != nil {
+		l.async.close()
+	}
+	if l.stream != nil {
+		l.stream.close()
+	}
+	if l.closer != nil {
+		return l.closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// blockingWriter blocks every write until release is closed.
+type blockingWriter struct {
+	release chan struct{}
+	mu      sync.Mutex
+	buf     bytes.Buffer
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *blockingWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.String()
+}
+
+func TestWithAsync(t *testing.T) {
+	w := &blockingWriter{release: make(chan struct{})}
+	zl := zerolog.New(w)
+	logger := New(&zl, WithAsync(16)).(*Logger)
+
+	done := make(chan struct{})
+	go func() {
+		logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+		logger.LogEvent(&fxevent.Started{})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected LogEvent not to block on a stalled writer")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := logger.Flush(ctx); err == nil {
+		t.Error("Expected Flush to time out while the writer is stalled")
+	}
+
+	close(w.release)
+	if err := logger.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	out := w.String()
+	if !strings.Contains(out, "invoking") || !strings.Contains(out, "started") {
+		t.Errorf("Expected queued records to be written, got %s", out)
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	logger.LogEvent(&fxevent.Stopped{Err: context.Canceled})
+	if !strings.Contains(w.String(), "stop failed") {
+		t.Error("Expected records after Close to be written synchronously")
+	}
+}
+
+func TestWithAsync_DropsOldest(t *testing.T) {
+	w := &blockingWriter{release: make(chan struct{})}
+	zl := zerolog.New(w)
+	logger := New(&zl, WithAsync(2)).(*Logger)
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		logger.LogEvent(&fxevent.Invoking{FunctionName: name})
+	}
+	close(w.release)
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The worker may have taken "a" before the ring filled up.
+	drops := logger.Stats().AsyncDrops
+	if drops < 2 || drops > 3 {
+		t.Errorf("Expected 2 or 3 dropped records, got %d", drops)
+	}
+	out := w.String()
+	if !strings.Contains(out, `"function":"d"`) || !strings.Contains(out, `"function":"e"`) {
+		t.Errorf("Expected the newest records to be kept, got %s", out)
+	}
+}
+
+func TestFlush_Deduplication(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDeduplication())
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if buf.Len() > 0 {
+		t.Fatal("Expected the record to be held back")
+	}
+	if err := logger.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "invoking") {
+		t.Errorf("Expected Flush to write the held-back record, got %s", buf.String())
+	}
+}
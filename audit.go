@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// AuditHashKey is the field holding the rolling hash added to audit records
+// by WithAuditChain.
+const AuditHashKey = "audit_hash"
+
+// auditRecord is a record of the audit trail.
+type auditRecord struct {
+	level    zerolog.Level
+	kind     EventKind
+	err      error
+	key, val string // the record's string field, if any
+}
+
+// logAudit writes the lifecycle milestones to the audit logger. Audit
+// records bypass filtering, sampling and rate limiting, and are logged at
+// info level, or error level for failures, so the audit logger's own level
+// alone decides what it keeps.
+func (l *Logger) logAudit(event fxevent.Event) {
+	var r auditRecord
+	switch e := event.(type) {
+	case *fxevent.LoggerInitialized:
+		if e.Err != nil {
+			r = auditRecord{level: zerolog.ErrorLevel, kind: KindLoggerInitFailed, err: e.Err}
+		} else {
+			r = auditRecord{level: zerolog.InfoLevel, kind: KindLoggerInitialized, key: "function", val: e.ConstructorName}
+		}
+	case *fxevent.Started:
+		if e.Err != nil {
+			r = auditRecord{level: zerolog.ErrorLevel, kind: KindStartFailed, err: e.Err}
+		} else {
+			r = auditRecord{level: zerolog.InfoLevel, kind: KindStarted}
+		}
+	case *fxevent.RollingBack:
+		r = auditRecord{level: zerolog.ErrorLevel, kind: KindRollingBack, err: e.StartErr}
+	case *fxevent.Stopping:
+		r = auditRecord{level: zerolog.InfoLevel, kind: KindStopping, key: "signal", val: strings.ToUpper(e.Signal.String())}
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			r = auditRecord{level: zerolog.ErrorLevel, kind: KindStopFailed, err: e.Err}
+		} else {
+			r = auditRecord{level: zerolog.InfoLevel, kind: KindStopped}
+		}
+	default:
+		return
+	}
+	l.writeAudit(r)
+}
+
+// writeAudit writes r to the audit logger, with the message of its kind,
+// chained to the previous record if WithAuditChain is set.
+func (l *Logger) writeAudit(r auditRecord) {
+	ev := l.audit.WithLevel(r.level)
+	if !ev.Enabled() {
+		// Records the audit logger drops are not chained, so the chain
+		// only covers the records written.
+		return
+	}
+	msg := l.message(r.kind)
+	if l.chain != nil {
+		// The record is rendered once on its own to hash it whole,
+		// including the audit logger's context fields, so it carries its
+		// time explicitly for both renderings to agree.
+		now := l.clock.Now()
+		var buf bytes.Buffer
+		probe := l.audit.Output(&buf)
+		r.fields(probe.WithLevel(r.level)).Time(zerolog.TimestampFieldName, now).Msg(msg)
+		ev = r.fields(ev).Time(zerolog.TimestampFieldName, now).Str(AuditHashKey, l.chain.next(buf.Bytes()))
+	} else {
+		ev = r.fields(ev)
+	}
+	ev.Msg(msg)
+}
+
+// fields adds the record's fields to ev.
+func (r auditRecord) fields(ev *zerolog.Event) *zerolog.Event {
+	if len(r.key) > 0 {
+		ev = ev.Str(r.key, r.val)
+	}
+	if r.err != nil {
+		ev = ev.Err(r.err)
+	}
+	return ev
+}
+
+// auditChain computes the rolling hash of the audit trail.
+type auditChain struct {
+	key  []byte
+	prev string // hash of the previous record, in hex
+}
+
+// next returns the hash of a JSON record, chained to the previous one, and
+// makes it the previous one. The hash covers every field of the record but
+// AuditHashKey, in sorted key order, with their values as compact JSON; a
+// line that is not a JSON object is hashed as is.
+func (c *auditChain) next(record []byte) string {
+	var h hash.Hash
+	if c.key != nil {
+		h = hmac.New(sha256.New, c.key)
+	} else {
+		h = sha256.New()
+	}
+	io.WriteString(h, c.prev)
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(record, &fields); err != nil {
+		h.Write(record)
+	}
+	keys := slices.Sorted(maps.Keys(fields))
+	var val bytes.Buffer
+	for _, key := range keys {
+		if key == AuditHashKey {
+			continue
+		}
+		val.Reset()
+		if err := json.Compact(&val, fields[key]); err != nil {
+			val.Write(fields[key])
+		}
+		fmt.Fprintf(h, "\n%q=%s", key, val.Bytes())
+	}
+	c.prev = hex.EncodeToString(h.Sum(nil))
+	return c.prev
+}
+
+// VerifyAuditChain reads an audit trail written with WithAuditChain and key
+// from r, one JSON record per line, and returns an error identifying the
+// first record that was modified, removed or inserted. Records removed from
+// the end of the trail cannot be detected.
+func VerifyAuditChain(r io.Reader, key []byte) error {
+	chain := &auditChain{key: key}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		var record struct {
+			Hash string `json:"audit_hash"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("audit record %d: %w", line, err)
+		}
+		if got, want := record.Hash, chain.next(scanner.Bytes()); got != want {
+			return fmt.Errorf("audit record %d: chain broken, %s is %q, expected %q", line, AuditHashKey, got, want)
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithAuditLogger(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit)
+	logger, buf := newTestLoggerWith(WithAuditLogger(&al), WithQuietStartup())
+
+	logger.LogEvent(&fxevent.LoggerInitialized{ConstructorName: "main.logger()"})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.Stopped{})
+
+	out := audit.String()
+	for _, msg := range []string{"initialized custom fxevent.Logger", "started", "received signal", "stopped"} {
+		if !strings.Contains(out, `"message":"`+msg+`"`) {
+			t.Errorf("Expected %q in the audit trail, got %s", msg, out)
+		}
+	}
+	if strings.Contains(out, "provided") || strings.Contains(out, "OnStart") {
+		t.Errorf("Expected no graph or hook records in the audit trail, got %s", out)
+	}
+	if strings.Contains(buf.String(), `"message":"stopped"`) {
+		t.Errorf("Expected main stream to be unaffected, got %s", buf.String())
+	}
+}
+
+func TestWithAuditLogger_Level(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit).Level(zerolog.ErrorLevel)
+	logger, buf := newTestLoggerWith(WithAuditLogger(&al))
+
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	out := audit.String()
+	if strings.Contains(out, `"started"`) || !strings.Contains(out, "stop failed") {
+		t.Errorf("Expected only errors in the audit trail, got %s", out)
+	}
+	if !strings.Contains(buf.String(), `"message":"started"`) {
+		t.Errorf("Expected the main stream to keep its own level, got %s", buf.String())
+	}
+}
+
+func TestWithAuditChain(t *testing.T) {
+	for _, key := range [][]byte{nil, []byte("secret")} {
+		audit := &bytes.Buffer{}
+		al := zerolog.New(audit).With().Str("service", "api").Logger()
+		logger, _ := newTestLoggerWith(WithAuditLogger(&al), WithAuditChain(key))
+
+		logger.LogEvent(&fxevent.LoggerInitialized{ConstructorName: "main.logger()"})
+		logger.LogEvent(&fxevent.Started{})
+		logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+		logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+
+		trail := audit.String()
+		if n := strings.Count(trail, `"`+AuditHashKey+`":"`); n != 4 {
+			t.Fatalf("Expected 4 chained records, got %d in %s", n, trail)
+		}
+		if err := VerifyAuditChain(strings.NewReader(trail), key); err != nil {
+			t.Errorf("Expected the trail to verify, got %v", err)
+		}
+
+		lines := strings.SplitAfter(trail, "\n")
+		removed := lines[0] + lines[2] + lines[3]
+		if err := VerifyAuditChain(strings.NewReader(removed), key); err == nil || !strings.Contains(err.Error(), "audit record 2") {
+			t.Errorf("Expected a removed record to break the chain at record 2, got %v", err)
+		}
+		modified := strings.Replace(trail, "INTERRUPT", "TERMINATED", 1)
+		if err := VerifyAuditChain(strings.NewReader(modified), key); err == nil || !strings.Contains(err.Error(), "audit record 3") {
+			t.Errorf("Expected a modified record to break the chain at record 3, got %v", err)
+		}
+	}
+}
+
+func TestWithAuditChain_WholeRecord(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit).With().Str("service", "api").Logger()
+	logger, _ := newTestLoggerWith(WithAuditLogger(&al), WithAuditChain(nil),
+		WithClock(fixedClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{})
+
+	trail := audit.String()
+	if !strings.Contains(trail, `"time":"2025-01-02T03:04:05Z"`) {
+		t.Fatalf("Expected chained records to carry their time, got %s", trail)
+	}
+	if err := VerifyAuditChain(strings.NewReader(trail), nil); err != nil {
+		t.Fatalf("Expected the trail to verify, got %v", err)
+	}
+	for _, edit := range []struct{ old, new string }{
+		{`"service":"api"`, `"service":"web"`},
+		{`"time":"2025-01-02T03:04:05Z"`, `"time":"2025-01-02T03:04:06Z"`},
+		{`"service":"api"`, `"service":"api","extra":true`},
+	} {
+		modified := strings.Replace(trail, edit.old, edit.new, 1)
+		if err := VerifyAuditChain(strings.NewReader(modified), nil); err == nil || !strings.Contains(err.Error(), "audit record 1") {
+			t.Errorf("Expected replacing %s with %s to break the chain at record 1, got %v", edit.old, edit.new, err)
+		}
+	}
+}
+
+func TestWithAuditChain_Key(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit)
+	logger, _ := newTestLoggerWith(WithAuditLogger(&al), WithAuditChain([]byte("secret")))
+	logger.LogEvent(&fxevent.Started{})
+
+	if err := VerifyAuditChain(strings.NewReader(audit.String()), []byte("other")); err == nil {
+		t.Error("Expected verification with the wrong key to fail")
+	}
+	if err := VerifyAuditChain(strings.NewReader(audit.String()), nil); err == nil {
+		t.Error("Expected verification without the key to fail")
+	}
+}
+
+func TestWithAuditChain_Level(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit).Level(zerolog.ErrorLevel)
+	logger, _ := newTestLoggerWith(WithAuditLogger(&al), WithAuditChain(nil))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+
+	if err := VerifyAuditChain(strings.NewReader(audit.String()), nil); err != nil {
+		t.Errorf("Expected records dropped by the audit level not to be chained, got %v", err)
+	}
+}
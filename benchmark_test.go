@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// benchmarkEvents are the events measured by BenchmarkLogEvent.
+var benchmarkEvents = []struct {
+	name  string
+	event fxevent.Event
+}{
+	{"OnStartExecuting", &fxevent.OnStartExecuting{FunctionName: "main.start", CallerName: "main.run"}},
+	{"OnStartExecuted", &fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: time.Millisecond}},
+	{"OnStopExecuting", &fxevent.OnStopExecuting{FunctionName: "main.stop", CallerName: "main.run"}},
+	{"OnStopExecuted", &fxevent.OnStopExecuted{FunctionName: "main.stop", CallerName: "main.run", Runtime: time.Millisecond}},
+	{"Supplied", &fxevent.Supplied{TypeName: "*main.Config", ModuleName: "config"}},
+	{"Provided", &fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, ModuleName: "server", StackTrace: []string{"main.go:10"}, ModuleTrace: []string{"main.go:5"}}},
+	{"Replaced", &fxevent.Replaced{OutputTypeNames: []string{"*main.Server"}}},
+	{"Decorated", &fxevent.Decorated{DecoratorName: "main.Decorate()", OutputTypeNames: []string{"*main.Server"}}},
+	{"Run", &fxevent.Run{Name: "main.NewServer()", Kind: "provide", Runtime: time.Millisecond}},
+	{"Invoking", &fxevent.Invoking{FunctionName: "main.Register()"}},
+	{"Invoked", &fxevent.Invoked{FunctionName: "main.Register()"}},
+	{"InvokedErr", &fxevent.Invoked{FunctionName: "main.Register()", Err: errors.New("boom")}},
+	{"Stopping", &fxevent.Stopping{Signal: os.Interrupt}},
+	{"Stopped", &fxevent.Stopped{}},
+	{"RollingBack", &fxevent.RollingBack{StartErr: errors.New("boom")}},
+	{"RolledBack", &fxevent.RolledBack{}},
+	{"Started", &fxevent.Started{}},
+	{"LoggerInitialized", &fxevent.LoggerInitialized{ConstructorName: "main.NewLogger()"}},
+}
+
+func BenchmarkLogEvent(b *testing.B) {
+	for _, bb := range benchmarkEvents {
+		b.Run(bb.name, func(b *testing.B) {
+			logger := NewDiscard()
+			b.ReportAllocs()
+			for b.Loop() {
+				logger.LogEvent(bb.event)
+			}
+		})
+	}
+}
+
+func BenchmarkLogEvent_PresetDefault(b *testing.B) {
+	logger := NewDiscard(PresetDefault())
+	event := benchmarkEvents[5].event
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.LogEvent(event)
+	}
+}
+
+func BenchmarkLogEvent_ProvidedManyTypes(b *testing.B) {
+	logger := NewDiscard()
+	event := &fxevent.Provided{
+		ConstructorName: "main.NewServer()",
+		OutputTypeNames: []string{"*main.Server", "http.Handler", "fmt.Stringer", "io.Closer"},
+		ModuleName:      "server",
+		StackTrace:      []string{"main.go:10"},
+		ModuleTrace:     []string{"main.go:5"},
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.LogEvent(event)
+	}
+}
+
+func BenchmarkModuleLevel(b *testing.B) {
+	logger := NewDiscard(WithModuleLevel("outer", zerolog.DebugLevel))
+	trace := []string{"main.New (main.go:1)", "main.main (main.go:5) (inner)", "main.main (main.go:3) (outer)"}
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.moduleLevel("server", trace)
+	}
+}
+
+func BenchmarkLogEvent_PresetProduction(b *testing.B) {
+	logger := NewDiscard(PresetProduction())
+	event := &fxevent.OnStartExecuted{
+		FunctionName: "example.com/app/server.(*Server).Start",
+		CallerName:   "example.com/app/server.New",
+		Runtime:      time.Millisecond,
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		logger.LogEvent(event)
+	}
+}
+
+func BenchmarkShortName(b *testing.B) {
+	const name = "example.com/app/server.(*Server).Start"
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			shortName(name)
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		var c nameCache
+		b.ReportAllocs()
+		for b.Loop() {
+			c.shortName(name)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// BufferedLogger is an fxevent.Logger that queues events in memory until the
+// real logger exists, then replays them to it.
+type BufferedLogger struct {
+	mu     sync.Mutex
+	events []fxevent.Event
+	target fxevent.Logger
+}
+
+var _ fxevent.Logger = (*BufferedLogger)(nil)
+
+// Buffered returns a BufferedLogger. Depending on the wiring order, fx may
+// emit dependency graph events before the application's logger is fully set
+// up; a BufferedLogger holds on to them until ReplayTo or Attach is called,
+// so no early events are lost.
+func Buffered() *BufferedLogger {
+	return &BufferedLogger{}
+}
+
+// LogEvent queues event, or passes it straight on once a logger has been
+// attached.
+func (b *BufferedLogger) LogEvent(event fxevent.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.target != nil {
+		b.target.LogEvent(event)
+		return
+	}
+	b.events = append(b.events, event)
+}
+
+// ReplayTo passes the queued events to logger, in order, and every later
+// event as it arrives. Calling it again switches to another logger; events
+// already replayed are not repeated.
+func (b *BufferedLogger) ReplayTo(logger fxevent.Logger) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, event := range b.events {
+		logger.LogEvent(event)
+	}
+	b.events = nil
+	b.target = logger
+}
+
+// Attach creates a Logger that writes to logger, configured by the given
+// options, and replays the queued events to it. It returns the new Logger.
+func (b *BufferedLogger) Attach(logger *zerolog.Logger, opts ...Option) *Logger {
+	l := New(logger, opts...).(*Logger)
+	b.ReplayTo(l)
+	return l
+}
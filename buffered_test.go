@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestBuffered(t *testing.T) {
+	b := Buffered()
+	b.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}})
+	b.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	logger := b.Attach(&zl, WithoutStackTraces())
+	out := buf.String()
+	if !strings.Contains(out, "provided") || strings.Index(out, "provided") > strings.Index(out, "invoking") {
+		t.Errorf("Expected queued events to be replayed in order, got %s", out)
+	}
+
+	b.LogEvent(&fxevent.Started{})
+	if !strings.Contains(buf.String(), "started") {
+		t.Errorf("Expected later events to be passed on, got %s", buf.String())
+	}
+	if n := logger.Stats().Events["Provided"]; n != 1 {
+		t.Errorf("Expected the attached logger to see the replayed event once, got %d", n)
+	}
+}
+
+func TestBuffered_ReplayTo(t *testing.T) {
+	b := Buffered()
+	b.LogEvent(&fxevent.Started{})
+
+	first, second := &eventRecorder{}, &eventRecorder{}
+	b.ReplayTo(first)
+	b.ReplayTo(second)
+	b.LogEvent(&fxevent.Stopped{})
+
+	if len(first.events) != 1 {
+		t.Errorf("Expected the first logger to receive the replayed event, got %d", len(first.events))
+	}
+	if len(second.events) != 1 {
+		t.Errorf("Expected the second logger to receive only new events, got %d", len(second.events))
+	}
+}
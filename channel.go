@@ -0,0 +1,19 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "go.uber.org/fx/fxevent"
+
+// mirror sends event to the event channel without blocking, counting it in
+// Stats.ChannelDrops if the channel is full.
+func (l *Logger) mirror(event fxevent.Event) {
+	if l.events == nil {
+		return
+	}
+	select {
+	case l.events <- event:
+	default:
+		l.stats.dropped()
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithEventChannel(t *testing.T) {
+	ch := make(chan fxevent.Event, 2)
+	logger, buf := newTestLoggerWith(WithEventChannel(ch))
+
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.Started{})
+
+	if len(ch) != 2 {
+		t.Fatalf("Expected 2 buffered events, got %d", len(ch))
+	}
+	if _, ok := (<-ch).(*fxevent.Invoking); !ok {
+		t.Error("Expected events in order")
+	}
+	if drops := logger.Stats().ChannelDrops; drops != 1 {
+		t.Errorf("Expected 1 dropped event, got %d", drops)
+	}
+	if !strings.Contains(buf.String(), "started") {
+		t.Errorf("Expected dropped events to still be logged, got %s", buf.String())
+	}
+}
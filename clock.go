@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "time"
+
+// Clock tells the current time. It is used for the times and durations the
+// Logger computes itself, such as the startup duration, the shutdown summary,
+// the startup timeline and rate limiting, but not for the timestamps zerolog
+// adds. Implementations must be safe for concurrent use.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock backed by time.Now.
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
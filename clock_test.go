@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// stepClock is a Clock that advances by a second each time it is read.
+type stepClock struct {
+	now time.Time
+}
+
+func (c *stepClock) Now() time.Time {
+	c.now = c.now.Add(time.Second)
+	return c.now
+}
+
+func TestWithClock(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithClock(&stepClock{}), WithShutdownSummary())
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Stopped{})
+
+	if !strings.Contains(buf.String(), `"duration":"2s"`) {
+		t.Errorf("Expected the shutdown duration from the clock, got %s", buf.String())
+	}
+}
+
+func TestWithClock_Nil(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithClock(nil))
+	if _, ok := logger.clock.(systemClock); !ok {
+		t.Errorf("Expected the system clock for a nil Clock, got %T", logger.clock)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// The CloudEvents types of the lifecycle milestones.
+const (
+	CloudEventStarted     = "com.github.amari.fxevent.started"
+	CloudEventStartFailed = "com.github.amari.fxevent.start_failed"
+	CloudEventRollingBack = "com.github.amari.fxevent.rolling_back"
+	CloudEventStopping    = "com.github.amari.fxevent.stopping"
+	CloudEventStopped     = "com.github.amari.fxevent.stopped"
+	CloudEventStopFailed  = "com.github.amari.fxevent.stop_failed"
+)
+
+// CloudEvent is a CloudEvents 1.0 event, which encodes to the JSON event
+// format.
+type CloudEvent struct {
+	SpecVersion     string         `json:"specversion"`
+	ID              string         `json:"id"`
+	Source          string         `json:"source"`
+	Type            string         `json:"type"`
+	Time            time.Time      `json:"time"`
+	DataContentType string         `json:"datacontenttype,omitempty"`
+	Data            map[string]any `json:"data,omitempty"`
+}
+
+// CloudEventSender publishes CloudEvents, for example by converting them for
+// the CloudEvents SDK client or posting them to a broker.
+type CloudEventSender interface {
+	Send(ctx context.Context, event CloudEvent) error
+}
+
+// CloudEventSenderFunc adapts a function to a CloudEventSender.
+type CloudEventSenderFunc func(ctx context.Context, event CloudEvent) error
+
+// Send calls f.
+func (f CloudEventSenderFunc) Send(ctx context.Context, event CloudEvent) error {
+	return f(ctx, event)
+}
+
+// cloudEmitter turns lifecycle milestones into CloudEvents.
+type cloudEmitter struct {
+	sender CloudEventSender
+	source string
+	prefix string       // random prefix of event IDs, unique to the emitter
+	seq    atomic.Int64 // sequence number of the last event
+}
+
+func newCloudEmitter(sender CloudEventSender, source string) *cloudEmitter {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return &cloudEmitter{sender: sender, source: source, prefix: hex.EncodeToString(b)}
+}
+
+// cloudEvent returns the CloudEvent of a lifecycle milestone, reporting
+// false for other events.
+func (c *cloudEmitter) cloudEvent(event fxevent.Event, now time.Time) (CloudEvent, bool) {
+	data := make(map[string]any)
+	var typ string
+	switch e := event.(type) {
+	case *fxevent.Started:
+		typ = CloudEventStarted
+		if e.Err != nil {
+			typ, data["error"] = CloudEventStartFailed, e.Err.Error()
+		}
+	case *fxevent.RollingBack:
+		typ = CloudEventRollingBack
+		if e.StartErr != nil {
+			data["error"] = e.StartErr.Error()
+		}
+	case *fxevent.Stopping:
+		typ, data["signal"] = CloudEventStopping, strings.ToUpper(e.Signal.String())
+	case *fxevent.Stopped:
+		typ = CloudEventStopped
+		if e.Err != nil {
+			typ, data["error"] = CloudEventStopFailed, e.Err.Error()
+		}
+	default:
+		return CloudEvent{}, false
+	}
+	ce := CloudEvent{
+		SpecVersion: "1.0",
+		ID:          c.prefix + "-" + strconv.FormatInt(c.seq.Add(1), 10),
+		Source:      c.source,
+		Type:        typ,
+		Time:        now,
+	}
+	if len(data) > 0 {
+		ce.DataContentType, ce.Data = "application/json", data
+	}
+	return ce, true
+}
+
+// sendCloudEvent publishes event as a CloudEvent if it is a lifecycle
+// milestone, reporting a failure to send it with a warning.
+func (l *Logger) sendCloudEvent(event fxevent.Event) {
+	if l.cloud == nil {
+		return
+	}
+	ce, ok := l.cloud.cloudEvent(event, l.clock.Now())
+	if !ok {
+		return
+	}
+	if err := l.cloud.sender.Send(context.Background(), ce); err != nil {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.detached(zerolog.WarnLevel).Str("type", ce.Type).Err(err).Send(KindCloudEventFailed)
+	}
+}
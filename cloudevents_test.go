@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// fixedClock is a Clock that always reads the same time.
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestWithCloudEvents(t *testing.T) {
+	var sent []CloudEvent
+	sender := CloudEventSenderFunc(func(ctx context.Context, event CloudEvent) error {
+		sent = append(sent, event)
+		return nil
+	})
+	clock := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	logger, _ := newTestLoggerWith(WithCloudEvents(sender, "/apps/api"), WithClock(fixedClock(clock)))
+
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.RollingBack{StartErr: errors.New("bad")})
+
+	types := []string{CloudEventStarted, CloudEventStopping, CloudEventStopFailed, CloudEventRollingBack}
+	if len(sent) != len(types) {
+		t.Fatalf("Expected %d CloudEvents, got %+v", len(types), sent)
+	}
+	ids := make(map[string]bool)
+	for i, ce := range sent {
+		if ce.Type != types[i] || ce.Source != "/apps/api" || ce.SpecVersion != "1.0" || !ce.Time.Equal(clock) {
+			t.Errorf("Expected a %s event from /apps/api, got %+v", types[i], ce)
+		}
+		ids[ce.ID] = true
+	}
+	if len(ids) != len(sent) {
+		t.Errorf("Expected unique IDs, got %+v", sent)
+	}
+	if sent[0].Data != nil || sent[1].Data["signal"] != "INTERRUPT" || sent[2].Data["error"] != "boom" {
+		t.Errorf("Expected the milestone data, got %+v", sent)
+	}
+
+	b, err := json.Marshal(sent[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"type":"com.github.amari.fxevent.stop_failed","time":"2025-01-02T03:04:05Z","datacontenttype":"application/json","data":{"error":"boom"}`; !strings.Contains(string(b), want) {
+		t.Errorf("Expected the JSON event format, got %s", b)
+	}
+}
+
+func TestWithCloudEvents_SendError(t *testing.T) {
+	sender := CloudEventSenderFunc(func(ctx context.Context, event CloudEvent) error {
+		return errors.New("broker unavailable")
+	})
+	logger, buf := newTestLoggerWith(WithCloudEvents(sender, "/apps/api"))
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	if !strings.Contains(out, `"message":"failed to send CloudEvent"`) || !strings.Contains(out, "broker unavailable") {
+		t.Errorf("Expected a send failure warning, got %s", out)
+	}
+
+	var sink sinkRecorder
+	logger = NewWithSink(&sink, WithCloudEvents(sender, "/apps/api"), WithNormalizedMessages())
+	logger.LogEvent(&fxevent.Started{})
+	if len(sink) != 2 || sink[1].Message != "cloudevent send failed" || sink[1].Level != zerolog.WarnLevel {
+		t.Errorf("Expected the warning to be written to the sink with its kind's message, got %+v", sink)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Command fxlogview renders the JSON lifecycle logs written by
+// fxeventzerolog as a colored, time-aligned timeline, highlighting slow
+// hooks, for postmortem analysis of an application's startup and shutdown.
+//
+// It reads the named files, or standard input if there are none:
+//
+//	fxlogview -slow 250ms app.log
+//	kubectl logs my-pod | fxlogview
+//
+// Lines that are not JSON log records are passed through unchanged.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/amari/fxevent-zerolog/internal/term"
+)
+
+func main() {
+	slow := flag.Duration("slow", 100*time.Millisecond, "highlight hooks and runs at least this slow")
+	color := flag.String("color", "auto", "color the output: auto, always or never")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: fxlogview [flags] [file ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	v := &viewer{w: os.Stdout, slow: *slow}
+	switch *color {
+	case "auto":
+		v.color = term.IsTerminal(os.Stdout) && os.Getenv("NO_COLOR") == ""
+	case "always":
+		v.color = true
+	case "never":
+	default:
+		fmt.Fprintf(os.Stderr, "fxlogview: invalid -color %q\n", *color)
+		os.Exit(2)
+	}
+
+	if err := run(v, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "fxlogview: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run renders the named files, or standard input if there are none, and
+// the summary.
+func run(v *viewer, files []string) error {
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, name := range files {
+		if err := viewFile(v, name); err != nil {
+			return err
+		}
+	}
+	v.summary()
+	return nil
+}
+
+// viewFile renders the named file, or standard input for "-".
+func viewFile(v *viewer, name string) error {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	if err := v.view(r); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
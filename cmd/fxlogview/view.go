@@ -0,0 +1,240 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+)
+
+// ANSI escape codes used by the viewer.
+const (
+	colorReset  = "\x1b[0m"
+	colorBold   = "\x1b[1m"
+	colorDim    = "\x1b[2m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+)
+
+// subjectKeys are the keys naming what a record is about, in order of
+// preference, including their PresetCompact abbreviations.
+var subjectKeys = []string{"callee", "function", "constructor", "decorator", "name", "fn", "type", "ty", "signal", "sig"}
+
+// runtimeKeys are the keys of a hook's or run's runtime.
+var runtimeKeys = []string{"runtime", "rt"}
+
+// errorKeys are the keys of a record's error.
+var errorKeys = []string{zerolog.ErrorFieldName, "err"}
+
+// milestones are the default and WithNormalizedMessages messages of the
+// successful lifecycle milestones, which are rendered in bold.
+var milestones = map[string]bool{
+	"started":         true,
+	"received signal": true,
+	"signal received": true,
+	"stopped":         true,
+	"rolled back":     true,
+}
+
+// levelNames are the abbreviations of zerolog's level names.
+var levelNames = map[string]string{
+	"trace": "TRC",
+	"debug": "DBG",
+	"info":  "INF",
+	"warn":  "WRN",
+	"error": "ERR",
+	"fatal": "FTL",
+	"panic": "PNC",
+}
+
+// hook is a slow hook or run, reported in the summary.
+type hook struct {
+	name    string
+	runtime time.Duration
+}
+
+// viewer renders JSON log records as a timeline.
+type viewer struct {
+	w     io.Writer
+	slow  time.Duration // hooks and runs at least this slow are highlighted
+	color bool
+
+	first   time.Time // time of the first record, if known
+	records int
+	errors  int
+	slowest []hook // slow hooks and runs, in the order they were logged
+}
+
+// view renders every line of r.
+func (v *viewer) view(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		v.line(sc.Text())
+	}
+	return sc.Err()
+}
+
+// line renders a single line, or passes it through if it is not a log
+// record.
+func (v *viewer) line(line string) {
+	var rec map[string]any
+	if err := json.Unmarshal([]byte(line), &rec); err != nil {
+		fmt.Fprintln(v.w, line)
+		return
+	}
+	msg, ok := rec[zerolog.MessageFieldName].(string)
+	if !ok {
+		fmt.Fprintln(v.w, line)
+		return
+	}
+	v.records++
+
+	offset := strings.Repeat(" ", 10)
+	if t, ok := recordTime(rec[zerolog.TimestampFieldName]); ok {
+		if v.first.IsZero() {
+			v.first = t
+		}
+		offset = fmt.Sprintf("%+9.3fs", t.Sub(v.first).Seconds())
+	}
+
+	level, _ := rec[zerolog.LevelFieldName].(string)
+	errMsg := firstString(rec, errorKeys)
+	failed := errMsg != "" || level == "error" || level == "fatal" || level == "panic"
+	if failed {
+		v.errors++
+	}
+
+	var b strings.Builder
+	b.WriteString(v.paint(colorDim, offset))
+	b.WriteString("  ")
+	b.WriteString(v.paint(levelColor(level), levelName(level)))
+	b.WriteString("  ")
+	switch {
+	case failed:
+		b.WriteString(v.paint(colorRed, fmt.Sprintf("%-26s", msg)))
+	case milestones[msg]:
+		b.WriteString(v.paint(colorBold+colorGreen, fmt.Sprintf("%-26s", msg)))
+	default:
+		fmt.Fprintf(&b, "%-26s", msg)
+	}
+	if subject := firstString(rec, subjectKeys); subject != "" {
+		b.WriteString(" ")
+		b.WriteString(subject)
+	}
+	if d, ok := recordRuntime(rec); ok {
+		b.WriteString("  ")
+		if v.slow > 0 && d >= v.slow {
+			v.slowest = append(v.slowest, hook{name: firstString(rec, subjectKeys), runtime: d})
+			b.WriteString(v.paint(colorBold+colorRed, fxeventzerolog.HumanDuration(d)+" SLOW"))
+		} else {
+			b.WriteString(v.paint(colorDim, fxeventzerolog.HumanDuration(d)))
+		}
+	}
+	if errMsg != "" {
+		b.WriteString("  ")
+		b.WriteString(v.paint(colorRed, errMsg))
+	}
+	fmt.Fprintln(v.w, b.String())
+}
+
+// summary renders the number of records and errors and the slow hooks.
+func (v *viewer) summary() {
+	if v.records == 0 {
+		return
+	}
+	fmt.Fprintf(v.w, "\n%d records, %d errors, %d slow hooks (>= %s)\n", v.records, v.errors, len(v.slowest), v.slow)
+	for _, h := range v.slowest {
+		fmt.Fprintf(v.w, "  %s  %s\n", v.paint(colorRed, fmt.Sprintf("%8s", fxeventzerolog.HumanDuration(h.runtime))), h.name)
+	}
+}
+
+// paint wraps s in the given color, if colors are enabled.
+func (v *viewer) paint(color, s string) string {
+	if !v.color || color == "" {
+		return s
+	}
+	return color + s + colorReset
+}
+
+// levelName returns the three letter abbreviation of a level name.
+func levelName(level string) string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+	if level == "" {
+		return "???"
+	}
+	return fmt.Sprintf("%-3.3s", strings.ToUpper(level))
+}
+
+// levelColor returns the color of a level name.
+func levelColor(level string) string {
+	switch level {
+	case "error", "fatal", "panic":
+		return colorRed
+	case "warn":
+		return colorYellow
+	case "debug", "trace":
+		return colorDim
+	default:
+		return colorGreen
+	}
+}
+
+// firstString returns the first non-empty string value of rec among keys.
+func firstString(rec map[string]any, keys []string) string {
+	for _, key := range keys {
+		if s, ok := rec[key].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// recordRuntime returns the runtime of a hook or run record. Runtimes are
+// written as Go duration strings such as "1.5ms".
+func recordRuntime(rec map[string]any) (time.Duration, bool) {
+	for _, key := range runtimeKeys {
+		if s, ok := rec[key].(string); ok {
+			if d, err := time.ParseDuration(s); err == nil {
+				return d, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// recordTime parses a record's timestamp, written either in RFC 3339 or,
+// with zerolog.TimeFieldFormat set to one of the Unix formats, as a number
+// of seconds, milliseconds, microseconds or nanoseconds since the epoch.
+func recordTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	case float64:
+		// Tell the unit apart by magnitude: 1e11 seconds is in the year 5138.
+		switch abs := math.Abs(v); {
+		case abs < 1e11:
+			return time.Unix(0, int64(v*1e9)), true
+		case abs < 1e14:
+			return time.UnixMilli(int64(v)), true
+		case abs < 1e17:
+			return time.UnixMicro(int64(v)), true
+		default:
+			return time.Unix(0, int64(v)), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestViewer(t *testing.T) {
+	in := strings.Join([]string{
+		`{"level":"info","constructor":"main.New()","time":"2025-01-02T10:00:00Z","message":"provided"}`,
+		`not a record`,
+		`{"level":"info","callee":"main.start()","caller":"main.New()","runtime":"350.5ms","time":"2025-01-02T10:00:00.4Z","message":"OnStart hook executed"}`,
+		`{"level":"info","callee":"main.fast()","caller":"main.New()","runtime":"2ms","time":"2025-01-02T10:00:00.45Z","message":"OnStart hook executed"}`,
+		`{"level":"error","callee":"main.other()","caller":"main.New()","error":"boom","time":"2025-01-02T10:00:00.5Z","message":"OnStart hook failed"}`,
+	}, "\n")
+
+	var buf bytes.Buffer
+	v := &viewer{w: &buf, slow: 100 * time.Millisecond}
+	if err := v.view(strings.NewReader(in)); err != nil {
+		t.Fatal(err)
+	}
+	v.summary()
+	lines := strings.Split(buf.String(), "\n")
+
+	if !strings.HasPrefix(lines[0], "   +0.000s  INF  provided") || !strings.HasSuffix(lines[0], "main.New()") {
+		t.Errorf("Expected the provided record, got %q", lines[0])
+	}
+	if lines[1] != "not a record" {
+		t.Errorf("Expected other lines to pass through, got %q", lines[1])
+	}
+	if !strings.HasPrefix(lines[2], "   +0.400s") || !strings.HasSuffix(lines[2], "main.start()  350ms SLOW") {
+		t.Errorf("Expected a highlighted slow hook, got %q", lines[2])
+	}
+	if strings.Contains(lines[3], "SLOW") || !strings.HasSuffix(lines[3], "2ms") {
+		t.Errorf("Expected a fast hook, got %q", lines[3])
+	}
+	if !strings.Contains(lines[4], "ERR  OnStart hook failed") || !strings.HasSuffix(lines[4], "boom") {
+		t.Errorf("Expected the failure, got %q", lines[4])
+	}
+	if !strings.Contains(buf.String(), "4 records, 1 errors, 1 slow hooks (>= 100ms)\n     350ms  main.start()\n") {
+		t.Errorf("Expected a summary, got %s", buf.String())
+	}
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("Expected no colors, got %q", buf.String())
+	}
+}
+
+func TestViewer_Color(t *testing.T) {
+	var buf bytes.Buffer
+	v := &viewer{w: &buf, slow: time.Second, color: true}
+	v.line(`{"level":"info","message":"started"}`)
+	if !strings.Contains(buf.String(), colorBold+colorGreen+"started") {
+		t.Errorf("Expected a colored milestone, got %q", buf.String())
+	}
+}
+
+// TestViewer_Logger renders the output of the logger itself, with Unix
+// timestamps and PresetCompact keys.
+func TestViewer_Logger(t *testing.T) {
+	var in bytes.Buffer
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnixMs
+	defer func() { zerolog.TimeFieldFormat = time.RFC3339 }()
+	zl := zerolog.New(&in).With().Timestamp().Logger()
+	logger := fxeventzerolog.New(&zl, fxeventzerolog.PresetCompact())
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: 2 * time.Second})
+	logger.LogEvent(&fxevent.Started{})
+
+	var buf bytes.Buffer
+	v := &viewer{w: &buf, slow: time.Second}
+	if err := v.view(&in); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "+0.000s  INF  OnStart hook executed      main.start()  2s SLOW") {
+		t.Errorf("Expected the hook, got %s", out)
+	}
+	if !strings.Contains(out, "INF  started") {
+		t.Errorf("Expected the started record, got %s", out)
+	}
+}
@@ -0,0 +1,413 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// Config describes a Logger in plain values, so that it can be loaded from
+// the environment with ConfigFromEnv or from a file with ConfigFromFile. The
+// zero Config is New without options writing JSON.
+type Config struct {
+	// Level and ErrorLevel are the levels of records and of failures, such
+	// as "debug" or "warn". Empty keeps the defaults.
+	Level      string `json:"level" yaml:"level"`
+	ErrorLevel string `json:"error_level" yaml:"error_level"`
+	// Env is a deployment environment given to PresetFor, such as "dev",
+	// "staging" or "prod", applied before Preset. With "dev", the default
+	// Format is "console".
+	Env string `json:"env" yaml:"env"`
+	// Preset is one of "quiet", "default", "verbose", "debug", "production"
+	// or "compact", applied before the other settings.
+	Preset string `json:"preset" yaml:"preset"`
+	// Backend is one of "gcp", "datadog", "otel", "splunk", "gelf", "ecs"
+	// or "loki".
+	Backend string `json:"backend" yaml:"backend"`
+	// Format is "json", the default, "console" for NewConsole's output or
+	// "logfmt" for NewLogfmt's.
+	Format string `json:"format" yaml:"format"`
+	// SkipFxInternals drops the records of fx's own constructors.
+	SkipFxInternals bool `json:"skip_fx_internals" yaml:"skip_fx_internals"`
+	// MinHookRuntime skips successful hooks faster than it, such as "5ms".
+	MinHookRuntime string `json:"min_hook_runtime" yaml:"min_hook_runtime"`
+	// SuppressedTypes and RedactedTypes are regular expressions of type
+	// and constructor names, as given to WithSuppressedTypes and
+	// WithRedactedTypes.
+	SuppressedTypes []string `json:"suppressed_types" yaml:"suppressed_types"`
+	RedactedTypes   []string `json:"redacted_types" yaml:"redacted_types"`
+	// AllowedFields and DeniedFields are given to WithAllowedFields and
+	// WithDeniedFields.
+	AllowedFields []string `json:"allowed_fields" yaml:"allowed_fields"`
+	DeniedFields  []string `json:"denied_fields" yaml:"denied_fields"`
+}
+
+// ConfigError reports an invalid setting of a Config.
+type ConfigError struct {
+	// Key is the setting, such as "level", or the environment variable it
+	// was read from.
+	Key string
+	// File and Line locate the setting when it was read by ConfigFromFile.
+	// Line is 0 if unknown.
+	File string
+	Line int
+	Err  error
+}
+
+func (e *ConfigError) Error() string {
+	switch {
+	case e.Line > 0:
+		return fmt.Sprintf("fxeventzerolog: %s:%d: %s: %v", e.File, e.Line, e.Key, e.Err)
+	case len(e.File) > 0:
+		return fmt.Sprintf("fxeventzerolog: %s: %s: %v", e.File, e.Key, e.Err)
+	default:
+		return fmt.Sprintf("fxeventzerolog: %s: %v", e.Key, e.Err)
+	}
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// presetsByName are the presets a Config can name.
+var presetsByName = map[string]func() Option{
+	"quiet":      PresetQuiet,
+	"default":    PresetDefault,
+	"verbose":    PresetVerbose,
+	"debug":      PresetDebug,
+	"production": PresetProduction,
+	"compact":    PresetCompact,
+}
+
+// backendsByName are the backend presets a Config can name.
+var backendsByName = map[string]func() Option{
+	"gcp":     PresetGCP,
+	"datadog": PresetDatadog,
+	"otel":    PresetOTel,
+	"splunk":  func() Option { return PresetSplunk("") },
+	"gelf":    func() Option { return PresetGELF("") },
+	"ecs":     WithECSFields,
+	"loki":    WithLokiFields,
+}
+
+// Options returns the options c describes, or a *ConfigError for the first
+// invalid setting.
+func (c Config) Options() ([]Option, error) {
+	var opts []Option
+	if len(c.Env) > 0 {
+		opts = append(opts, PresetFor(c.Env))
+	}
+	if len(c.Preset) > 0 {
+		preset, ok := presetsByName[c.Preset]
+		if !ok {
+			return nil, &ConfigError{Key: "preset", Err: fmt.Errorf("unknown preset %q", c.Preset)}
+		}
+		opts = append(opts, preset())
+	}
+	if len(c.Backend) > 0 {
+		backend, ok := backendsByName[c.Backend]
+		if !ok {
+			return nil, &ConfigError{Key: "backend", Err: fmt.Errorf("unknown backend %q", c.Backend)}
+		}
+		opts = append(opts, backend())
+	}
+	switch c.Format {
+	case "", "json", "console", "logfmt":
+	default:
+		return nil, &ConfigError{Key: "format", Err: fmt.Errorf("unknown format %q", c.Format)}
+	}
+	for _, l := range []struct {
+		key, val string
+		opt      func(zerolog.Level) Option
+	}{
+		{"level", c.Level, WithLogLevel},
+		{"error_level", c.ErrorLevel, WithErrorLevel},
+	} {
+		if len(l.val) == 0 {
+			continue
+		}
+		lvl, err := zerolog.ParseLevel(l.val)
+		if err != nil {
+			return nil, &ConfigError{Key: l.key, Err: err}
+		}
+		opts = append(opts, l.opt(lvl))
+	}
+	if c.SkipFxInternals {
+		opts = append(opts, WithoutFxInternals())
+	}
+	if len(c.MinHookRuntime) > 0 {
+		d, err := time.ParseDuration(c.MinHookRuntime)
+		if err != nil {
+			return nil, &ConfigError{Key: "min_hook_runtime", Err: err}
+		}
+		opts = append(opts, WithMinHookRuntime(d))
+	}
+	for _, p := range []struct {
+		key      string
+		patterns []string
+		opt      func(...string) Option
+	}{
+		{"suppressed_types", c.SuppressedTypes, WithSuppressedTypes},
+		{"redacted_types", c.RedactedTypes, WithRedactedTypes},
+	} {
+		if len(p.patterns) == 0 {
+			continue
+		}
+		for _, pattern := range p.patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return nil, &ConfigError{Key: p.key, Err: err}
+			}
+		}
+		opts = append(opts, p.opt(p.patterns...))
+	}
+	if len(c.AllowedFields) > 0 {
+		opts = append(opts, WithAllowedFields(c.AllowedFields...))
+	}
+	if len(c.DeniedFields) > 0 {
+		opts = append(opts, WithDeniedFields(c.DeniedFields...))
+	}
+	return opts, nil
+}
+
+// New returns a Logger configured by c and then opts, writing JSON records
+// with a timestamp to w, logfmt records if c.Format is "logfmt", or
+// human-readable records if c.Format is "console" or c.Env is a development
+// environment.
+func (c Config) New(w io.Writer, opts ...Option) (*Logger, error) {
+	copts, err := c.Options()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(copts, opts...)
+	if c.Format == "console" || (len(c.Format) == 0 && isDevEnv(c.Env)) {
+		return NewConsole(w, opts...), nil
+	}
+	if c.Format == "logfmt" {
+		return NewLogfmt(w, opts...), nil
+	}
+	logger := zerolog.New(w).With().Timestamp().Logger()
+	return New(&logger, opts...).(*Logger), nil
+}
+
+// ConfigFromEnv reads a Config from environment variables named by prefix
+// and the setting, separated by an underscore. With the prefix "FXLOG":
+//
+//	FXLOG_ENV                dev, staging or prod, see PresetFor
+//	FXLOG_LEVEL              level, such as "info"
+//	FXLOG_ERROR_LEVEL        level of failures
+//	FXLOG_PRESET             quiet, default, verbose, debug, production or compact
+//	FXLOG_BACKEND            gcp, datadog, otel, splunk, gelf, ecs or loki
+//	FXLOG_FORMAT             json, console or logfmt
+//	FXLOG_SKIP_FX_INTERNALS  true to drop fx's own constructors
+//	FXLOG_MIN_HOOK_RUNTIME   duration, such as "5ms"
+//	FXLOG_SUPPRESSED_TYPES   comma-separated regular expressions
+//	FXLOG_REDACTED_TYPES     comma-separated regular expressions
+//	FXLOG_ALLOWED_FIELDS     comma-separated field keys
+//	FXLOG_DENIED_FIELDS      comma-separated field keys
+//
+// Unset variables keep their zero value. An invalid value is reported as a
+// *ConfigError naming the variable.
+func ConfigFromEnv(prefix string) (Config, error) {
+	if len(prefix) > 0 && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+	env := func(key string) string {
+		return os.Getenv(prefix + strings.ToUpper(key))
+	}
+	list := func(key string) []string {
+		var vals []string
+		for val := range strings.SplitSeq(env(key), ",") {
+			if val = strings.TrimSpace(val); len(val) > 0 {
+				vals = append(vals, val)
+			}
+		}
+		return vals
+	}
+
+	c := Config{
+		Env:             env("env"),
+		Level:           env("level"),
+		ErrorLevel:      env("error_level"),
+		Preset:          env("preset"),
+		Backend:         env("backend"),
+		Format:          env("format"),
+		MinHookRuntime:  env("min_hook_runtime"),
+		SuppressedTypes: list("suppressed_types"),
+		RedactedTypes:   list("redacted_types"),
+		AllowedFields:   list("allowed_fields"),
+		DeniedFields:    list("denied_fields"),
+	}
+	if val := env("skip_fx_internals"); len(val) > 0 {
+		skip, err := strconv.ParseBool(val)
+		if err != nil {
+			return Config{}, &ConfigError{Key: prefix + "SKIP_FX_INTERNALS", Err: err}
+		}
+		c.SkipFxInternals = skip
+	}
+	if _, err := c.Options(); err != nil {
+		if ce, ok := err.(*ConfigError); ok {
+			ce.Key = prefix + strings.ToUpper(ce.Key)
+		}
+		return Config{}, err
+	}
+	return c, nil
+}
+
+// ConfigFromFile reads a Config from a JSON (.json) or YAML (.yaml, .yml)
+// file, with the keys of Config's json tags:
+//
+//	preset: production
+//	level: info
+//	suppressed_types:
+//	  - ^\*internal\.
+//
+// Unknown keys and invalid values are reported as a *ConfigError with the
+// offending key and its line, so the logger can share a service's config
+// file and still fail with a precise message.
+func ConfigFromFile(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	return parseConfig(path, data)
+}
+
+// parseConfig parses the contents of the config file at path.
+func parseConfig(path string, data []byte) (Config, error) {
+	var (
+		c     Config
+		lines map[string]int
+		err   error
+	)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		lines, err = decodeJSONConfig(data, &c)
+	case ".yaml", ".yml":
+		lines, err = decodeYAMLConfig(data, &c)
+	default:
+		return Config{}, fmt.Errorf("fxeventzerolog: %s: unsupported config extension %q, expected .json, .yaml or .yml", path, ext)
+	}
+	if err == nil {
+		_, err = c.Options()
+	}
+	var ce *ConfigError
+	if errors.As(err, &ce) {
+		ce.File = path
+		if ce.Line == 0 {
+			ce.Line = lines[ce.Key]
+		}
+		return Config{}, ce
+	}
+	if err != nil {
+		return Config{}, fmt.Errorf("fxeventzerolog: %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// decodeJSONConfig decodes data into c, rejecting unknown keys, and returns
+// the line of each top-level key.
+func decodeJSONConfig(data []byte, c *Config) (map[string]int, error) {
+	lines, err := jsonKeyLines(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(c)
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr):
+		return lines, &ConfigError{Key: typeErr.Field, Err: fmt.Errorf("expected %s, got %s", typeErr.Type, typeErr.Value)}
+	case err != nil:
+		// Unknown keys are only reported in the error's text.
+		if key, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			key, _ = strconv.Unquote(key)
+			return lines, &ConfigError{Key: key, Err: errors.New("unknown key")}
+		}
+		return lines, err
+	}
+	return lines, nil
+}
+
+// jsonKeyLines returns the line of each key of the JSON object in data.
+func jsonKeyLines(data []byte) (map[string]int, error) {
+	lines := make(map[string]int)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, errors.New("expected a JSON object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		lines[key] = 1 + bytes.Count(data[:dec.InputOffset()], []byte("\n"))
+		var val json.RawMessage
+		if err := dec.Decode(&val); err != nil {
+			return nil, err
+		}
+	}
+	return lines, nil
+}
+
+// yamlLine matches the line number at the start of a YAML decoding error.
+var yamlLine = regexp.MustCompile(`^line (\d+): `)
+
+// decodeYAMLConfig decodes data into c, rejecting unknown keys, and returns
+// the line of each top-level key.
+func decodeYAMLConfig(data []byte, c *Config) (map[string]int, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	lines := make(map[string]int)
+	if len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+		content := doc.Content[0].Content
+		for i := 0; i+1 < len(content); i += 2 {
+			lines[content[i].Value] = content[i].Line
+		}
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	err := dec.Decode(c)
+	if errors.Is(err, io.EOF) {
+		return lines, nil
+	}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) && len(typeErr.Errors) > 0 {
+		msg := typeErr.Errors[0]
+		ce := &ConfigError{Err: errors.New(msg)}
+		if m := yamlLine.FindStringSubmatch(msg); m != nil {
+			ce.Line, _ = strconv.Atoi(m[1])
+			ce.Err = errors.New(msg[len(m[0]):])
+			// The offending key is the last one starting at or before
+			// the line, which may hold one of its list items.
+			found := 0
+			for key, line := range lines {
+				if line <= ce.Line && line > found {
+					ce.Key, found = key, line
+				}
+			}
+		}
+		return lines, ce
+	}
+	return lines, err
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("FXLOG_LEVEL", "debug")
+	t.Setenv("FXLOG_PRESET", "production")
+	t.Setenv("FXLOG_BACKEND", "gcp")
+	t.Setenv("FXLOG_SKIP_FX_INTERNALS", "true")
+	t.Setenv("FXLOG_MIN_HOOK_RUNTIME", "5ms")
+	t.Setenv("FXLOG_SUPPRESSED_TYPES", `^\*main\.Cache$, ^\*main\.Pool$`)
+	t.Setenv("FXLOG_DENIED_FIELDS", "caller")
+
+	c, err := ConfigFromEnv("FXLOG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Level != "debug" || c.Preset != "production" || c.Backend != "gcp" || !c.SkipFxInternals || c.MinHookRuntime != "5ms" {
+		t.Errorf("Expected the settings from the environment, got %+v", c)
+	}
+	if !slices.Equal(c.SuppressedTypes, []string{`^\*main\.Cache$`, `^\*main\.Pool$`}) {
+		t.Errorf("Expected two suppressed type patterns, got %q", c.SuppressedTypes)
+	}
+
+	buf := &bytes.Buffer{}
+	logger, err := c.New(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewCache()", OutputTypeNames: []string{"*main.Cache"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.fast()", CallerName: "main.New()", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.slow()", CallerName: "main.New()", Runtime: 10 * time.Millisecond})
+
+	out := buf.String()
+	if strings.Contains(out, "main.Cache") || strings.Contains(out, "main.fast()") || strings.Contains(out, "caller") {
+		t.Errorf("Expected the configured filters, got %s", out)
+	}
+	if !strings.Contains(out, `"level":"debug"`) || !strings.Contains(out, `"severity":"DEBUG"`) || !strings.Contains(out, `"callee":"main.slow()"`) {
+		t.Errorf("Expected the configured level and backend, got %s", out)
+	}
+}
+
+func TestConfigFromEnv_Unset(t *testing.T) {
+	c, err := ConfigFromEnv("FXLOG_TEST_UNSET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := c.Options()
+	if err != nil || len(opts) != 0 {
+		t.Errorf("Expected no options, got %d, %v", len(opts), err)
+	}
+}
+
+func TestConfigFromEnv_Invalid(t *testing.T) {
+	tests := []struct {
+		name, val string
+	}{
+		{"APP_LEVEL", "loud"},
+		{"APP_PRESET", "chatty"},
+		{"APP_BACKEND", "papertrail"},
+		{"APP_FORMAT", "xml"},
+		{"APP_SKIP_FX_INTERNALS", "maybe"},
+		{"APP_MIN_HOOK_RUNTIME", "soon"},
+		{"APP_REDACTED_TYPES", "("},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.name, tt.val)
+			_, err := ConfigFromEnv("APP_")
+			var ce *ConfigError
+			if !errors.As(err, &ce) || ce.Key != tt.name {
+				t.Errorf("Expected a ConfigError for %s, got %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestConfig_Console(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger, err := Config{Format: "console"}.New(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Started{})
+	if out := buf.String(); !strings.Contains(out, "INF started") {
+		t.Errorf("Expected console output, got %q", out)
+	}
+}
+
+func TestConfig_Logfmt(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger, err := Config{Format: "logfmt"}.New(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Started{})
+	if out := buf.String(); !strings.HasPrefix(out, "time=") || !strings.Contains(out, "level=info msg=started\n") {
+		t.Errorf("Expected logfmt output, got %q", out)
+	}
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigFromFile(t *testing.T) {
+	want := Config{Level: "warn", Preset: "quiet", SuppressedTypes: []string{`^\*main\.Cache$`}, SkipFxInternals: true}
+	files := map[string]string{
+		"fxlog.yaml": "level: warn\npreset: quiet\nskip_fx_internals: true\nsuppressed_types:\n  - ^\\*main\\.Cache$\n",
+		"fxlog.json": `{"level": "warn", "preset": "quiet", "skip_fx_internals": true, "suppressed_types": ["^\\*main\\.Cache$"]}`,
+	}
+	for name, content := range files {
+		c, err := ConfigFromFile(writeConfig(t, name, content))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !reflect.DeepEqual(c, want) {
+			t.Errorf("%s: expected %+v, got %+v", name, want, c)
+		}
+	}
+}
+
+func TestConfigFromFile_Errors(t *testing.T) {
+	tests := []struct {
+		name, content string
+		key           string
+		line          int
+	}{
+		{"unknown.yaml", "level: info\nlvl: debug\n", "lvl", 2},
+		{"type.yaml", "level: info\nskip_fx_internals: maybe\n", "skip_fx_internals", 2},
+		{"list.yml", "preset: quiet\nsuppressed_types:\n  - a\n  - [b]\n", "suppressed_types", 4},
+		{"invalid.yaml", "preset: quiet\nlevel: loud\n", "level", 2},
+		{"unknown.json", "{\n  \"level\": \"info\",\n  \"lvl\": \"debug\"\n}", "lvl", 3},
+		{"type.json", "{\n  \"skip_fx_internals\": \"yes\"\n}", "skip_fx_internals", 2},
+		{"invalid.json", "{\n  \"preset\": \"quiet\",\n  \"redacted_types\": [\"(\"]\n}", "redacted_types", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfig(t, tt.name, tt.content)
+			_, err := ConfigFromFile(path)
+			var ce *ConfigError
+			if !errors.As(err, &ce) {
+				t.Fatalf("Expected a ConfigError, got %v", err)
+			}
+			if ce.Key != tt.key || ce.Line != tt.line || ce.File != path {
+				t.Errorf("Expected %s at line %d, got %s at line %d: %v", tt.key, tt.line, ce.Key, ce.Line, err)
+			}
+			if want := fmt.Sprintf("%s:%d: %s: ", path, tt.line, tt.key); !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected the error to contain %q, got %q", want, err)
+			}
+		})
+	}
+}
+
+func TestConfigFromFile_Unsupported(t *testing.T) {
+	if _, err := ConfigFromFile(writeConfig(t, "fxlog.toml", "level = 'info'")); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("Expected an unsupported extension error, got %v", err)
+	}
+	if _, err := ConfigFromFile(writeConfig(t, "broken.json", "{")); err == nil {
+		t.Error("Expected a syntax error")
+	}
+	if c, err := ConfigFromFile(writeConfig(t, "empty.yaml", "")); err != nil || !reflect.DeepEqual(c, Config{}) {
+		t.Errorf("Expected an empty config, got %+v, %v", c, err)
+	}
+}
+
+func TestConfig_Env(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger, err := Config{Env: "dev"}.New(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Started{})
+	if out := buf.String(); !strings.Contains(out, "INF 🚀 started") {
+		t.Errorf("Expected console output for dev, got %q", out)
+	}
+
+	buf.Reset()
+	logger, err = Config{Env: "dev", Format: "json"}.New(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Started{})
+	if out := buf.String(); !strings.Contains(out, `"message":"started"`) {
+		t.Errorf("Expected JSON output, got %q", out)
+	}
+}
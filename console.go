@@ -0,0 +1,203 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/amari/fxevent-zerolog/internal/term"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// consoleLogger returns a zerolog logger writing human-readable output to w
+// at the given level.
+func consoleLogger(w io.Writer, lvl zerolog.Level) *zerolog.Logger {
+	cw := zerolog.ConsoleWriter{Out: w}
+	colorByClass(&cw)
+	logger := zerolog.New(cw).Level(lvl).With().Timestamp().Logger()
+	return &logger
+}
+
+// eventClassKey is the field carrying the event class to console writers,
+// which remove it.
+const eventClassKey = "fx.class"
+
+// Event classes, which console output is colored by.
+const (
+	classGraph     = "graph"     // dim
+	classHook      = "hook"      // normal
+	classMilestone = "milestone" // bold
+	classError     = "error"     // red
+)
+
+// eventClass returns the class of the entry's event.
+func eventClass(e *entry) string {
+	if e.level >= zerolog.ErrorLevel || (e.event != nil && eventError(e.event) != nil) {
+		return classError
+	}
+	switch e.event.(type) {
+	case *fxevent.Provided, *fxevent.Supplied, *fxevent.Decorated, *fxevent.Replaced,
+		*fxevent.Run, *fxevent.Invoking, *fxevent.Invoked:
+		return classGraph
+	case *fxevent.Started, *fxevent.Stopping, *fxevent.Stopped, *fxevent.RollingBack,
+		*fxevent.RolledBack, *fxevent.LoggerInitialized:
+		return classMilestone
+	default:
+		return classHook
+	}
+}
+
+// encodeConsole writes the entry to a logger writing through a console
+// writer, with its event class for coloring.
+func (e *entry) encodeConsole(logger *zerolog.Logger) {
+	msg := e.msg
+	if e.l.emoji {
+		msg = emojiPrefix(e.event) + msg
+	}
+	fields := e.fields
+	if e.l.consoleDur != nil {
+		fields = formatDurations(slices.Clone(fields), e.l.consoleDur)
+	}
+	encodeFields(logger.WithLevel(e.level), fields).Str(eventClassKey, eventClass(e)).Msg(msg)
+}
+
+// formatDurations replaces the duration fields, including nested ones, with
+// string fields holding their formatted value.
+func formatDurations(fields []field, format func(time.Duration) string) []field {
+	for i, f := range fields {
+		switch f.kind {
+		case durationField:
+			fields[i] = field{key: f.key, kind: stringField, str: format(time.Duration(f.num))}
+		case objectField, arrayField:
+			fields[i].sub = formatDurations(slices.Clone(f.sub), format)
+		}
+	}
+	return fields
+}
+
+// emojiPrefix returns the emoji prefixed to the console message of a
+// lifecycle milestone, or "".
+func emojiPrefix(event fxevent.Event) string {
+	switch e := event.(type) {
+	case *fxevent.Started:
+		if e.Err != nil {
+			return "💥 "
+		}
+		return "🚀 "
+	case *fxevent.Stopped:
+		return "🛑 "
+	case *fxevent.RollingBack, *fxevent.RolledBack:
+		return "💥 "
+	default:
+		return ""
+	}
+}
+
+// classColors are the ANSI escape codes of the message by event class.
+var classColors = map[string]string{
+	classGraph:     "\x1b[2m",
+	classMilestone: "\x1b[1m",
+	classError:     "\x1b[31m",
+}
+
+// colorByClass configures cw to remove the event class field and, unless
+// cw.NoColor is set, to color the message by it: graph events dim, hooks
+// normal, lifecycle milestones bold and errors red. It runs before any
+// FormatPrepare function cw already has.
+func colorByClass(cw *zerolog.ConsoleWriter) {
+	prepare, noColor := cw.FormatPrepare, cw.NoColor
+	cw.FormatMessage = func(i any) string {
+		if i == nil {
+			return ""
+		}
+		return fmt.Sprint(i)
+	}
+	cw.FormatPrepare = func(evt map[string]any) error {
+		class, _ := evt[eventClassKey].(string)
+		delete(evt, eventClassKey)
+		msg, ok := evt[zerolog.MessageFieldName].(string)
+		if color := classColors[class]; ok && len(color) > 0 && !noColor {
+			evt[zerolog.MessageFieldName] = color + msg + "\x1b[0m"
+		}
+		if prepare != nil {
+			return prepare(evt)
+		}
+		return nil
+	}
+}
+
+// consoleTimeFormat is the time format of NewConsole.
+const consoleTimeFormat = "15:04:05.000"
+
+// NewConsole returns a Logger configured by opts that writes human-readable
+// records to w for local development: short timestamps, hook callers without
+// their package path, runtimes rounded to three significant digits and
+// traces only on failed events. If w is a terminal, messages are colored by
+// event class: graph events dim, hooks normal, lifecycle milestones bold and
+// errors red.
+func NewConsole(w io.Writer, opts ...Option) *Logger {
+	cw := zerolog.ConsoleWriter{
+		Out:          w,
+		NoColor:      !term.IsTerminal(w),
+		TimeFormat:   consoleTimeFormat,
+		FormatCaller: trimCaller,
+	}
+	colorByClass(&cw)
+	logger := zerolog.New(cw).With().Timestamp().Logger()
+	defaults := []Option{WithTracesOnError(), WithConsoleDurations(roundedDuration)}
+	l := New(&logger, append(defaults, opts...)...).(*Logger)
+	l.consoleOut = true
+	// The records are already human-readable, so a WithConsole copy would
+	// only repeat them.
+	l.console = nil
+	return l
+}
+
+// trimCaller formats the caller field without its package path, e.g.
+// "server.(*Server).Start" rather than "example.com/app/server.(*Server).Start".
+func trimCaller(i any) string {
+	if i == nil {
+		return ""
+	}
+	c := fmt.Sprint(i)
+	if idx := strings.LastIndexByte(c, '/'); idx >= 0 {
+		c = c[idx+1:]
+	}
+	return c + " >"
+}
+
+// roundedDuration formats d rounded to three significant digits.
+func roundedDuration(d time.Duration) string {
+	return roundDuration(d).String()
+}
+
+// HumanDuration formats d rounded to two significant digits, such as 1.2s,
+// 480ms or 3µs, for use with WithConsoleDurations.
+func HumanDuration(d time.Duration) string {
+	return roundDigits(d, 2).String()
+}
+
+// roundDuration rounds d to three significant digits, e.g. 1.234567ms to
+// 1.23ms.
+func roundDuration(d time.Duration) time.Duration {
+	return roundDigits(d, 3)
+}
+
+// roundDigits rounds d to the given number of significant digits.
+func roundDigits(d time.Duration, digits int) time.Duration {
+	limit := time.Duration(1)
+	for range digits {
+		limit *= 10
+	}
+	p := time.Duration(1)
+	for d.Abs()/p >= limit {
+		p *= 10
+	}
+	return d.Round(p)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithConsole_NotTerminal(t *testing.T) {
+	console := &bytes.Buffer{}
+	logger, buf := newTestLoggerWith(WithConsole(console))
+	logger.LogEvent(&fxevent.Started{})
+	if console.Len() > 0 {
+		t.Errorf("Expected no console output to a non-terminal, got %s", console.String())
+	}
+	if !strings.Contains(buf.String(), `"message":"started"`) {
+		t.Errorf("Expected JSON output, got %s", buf.String())
+	}
+}
+
+func TestConsoleOutput(t *testing.T) {
+	console := &bytes.Buffer{}
+	logger, buf := newTestLoggerWith()
+	logger.console = consoleLogger(console, zerolog.InfoLevel)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	if !strings.Contains(buf.String(), `"function":"main.run()"`) {
+		t.Errorf("Expected JSON output, got %s", buf.String())
+	}
+	out := console.String()
+	if !strings.Contains(out, "INF") || !strings.Contains(out, "invoking") || !strings.Contains(out, "main.run()") {
+		t.Errorf("Expected console output, got %q", out)
+	}
+}
+
+func TestNewConsole(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewConsole(buf)
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "example.com/app/server.(*Server).Start", Runtime: 1234567 * time.Nanosecond})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, StackTrace: []string{"main.go:10"}})
+
+	out := buf.String()
+	if strings.Contains(out, "\x1b[") {
+		t.Errorf("Expected no colors when not writing to a terminal, got %q", out)
+	}
+	if !strings.Contains(out, "server.(*Server).Start > OnStart hook executed") || strings.Contains(out, "example.com") {
+		t.Errorf("Expected a trimmed caller, got %q", out)
+	}
+	if !strings.Contains(out, "runtime=1.23ms") {
+		t.Errorf("Expected a rounded runtime, got %q", out)
+	}
+	if strings.Contains(out, "stacktrace") {
+		t.Errorf("Expected no traces on successful events, got %q", out)
+	}
+}
+
+func TestRoundDuration(t *testing.T) {
+	tests := []struct {
+		d, want time.Duration
+	}{
+		{3 * time.Microsecond, 3 * time.Microsecond},
+		{480123 * time.Microsecond, 480 * time.Millisecond},
+		{1234567890 * time.Nanosecond, 1230 * time.Millisecond},
+		{999 * time.Nanosecond, 999 * time.Nanosecond},
+	}
+	for _, tt := range tests {
+		if got := roundDuration(tt.d); got != tt.want {
+			t.Errorf("Expected %v to round to %v, got %v", tt.d, tt.want, got)
+		}
+	}
+}
+
+func TestHumanDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{1234567890 * time.Nanosecond, "1.2s"},
+		{480123 * time.Microsecond, "480ms"},
+		{3210 * time.Nanosecond, "3.2µs"},
+		{3 * time.Microsecond, "3µs"},
+		{12 * time.Nanosecond, "12ns"},
+	}
+	for _, tt := range tests {
+		if got := HumanDuration(tt.d); got != tt.want {
+			t.Errorf("Expected %v to format as %s, got %s", tt.d, tt.want, got)
+		}
+	}
+}
+
+func TestWithConsoleDurations(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewConsole(buf, WithConsoleDurations(HumanDuration))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: 1234567 * time.Nanosecond})
+	if out := buf.String(); !strings.Contains(out, "runtime=1.2ms") {
+		t.Errorf("Expected a human-readable runtime, got %q", out)
+	}
+
+	buf.Reset()
+	logger = NewConsole(buf, WithConsoleDurations(time.Duration.String))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: 1234567 * time.Nanosecond})
+	if out := buf.String(); !strings.Contains(out, "runtime=1.234567ms") {
+		t.Errorf("Expected the exact runtime, got %q", out)
+	}
+
+	json, jbuf := newTestLoggerWith(WithConsoleDurations(HumanDuration))
+	json.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: 1234567 * time.Nanosecond})
+	if out := jbuf.String(); !strings.Contains(out, `"runtime":"1.234567ms"`) {
+		t.Errorf("Expected JSON to keep the full runtime, got %s", out)
+	}
+}
+
+func TestConsoleEventClasses(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cw := zerolog.ConsoleWriter{Out: buf}
+	colorByClass(&cw)
+	zl := zerolog.New(cw)
+	logger := New(&zl).(*Logger)
+	logger.consoleOut = true
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run"})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom")})
+
+	out := buf.String()
+	for _, want := range []string{"\x1b[2mprovided\x1b[0m", "\x1b[0m OnStart hook executed \x1b[", "\x1b[1mstarted\x1b[0m", "\x1b[31minvoke failed\x1b[0m"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in %q", want, out)
+		}
+	}
+	if strings.Contains(out, eventClassKey) {
+		t.Errorf("Expected the class field to be removed, got %q", out)
+	}
+}
+
+func TestWithConsoleEmoji(t *testing.T) {
+	console := &bytes.Buffer{}
+	logger := NewConsole(console, WithConsoleEmoji())
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.RollingBack{StartErr: errors.New("boom")})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := console.String()
+	for _, want := range []string{"🚀 started", "💥 start failed, rolling back", "🛑 stop failed", "INF invoking"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in %q", want, out)
+		}
+	}
+
+	json, buf := newTestLoggerWith(WithConsoleEmoji())
+	json.LogEvent(&fxevent.Started{})
+	if strings.Contains(buf.String(), "🚀") {
+		t.Errorf("Expected no emoji in JSON output, got %s", buf.String())
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/tabwriter"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// phaseNames are the names of the lifecycle phases in debug reports.
+var phaseNames = map[lifecyclePhase]string{
+	phaseInit:        "init",
+	phaseStarting:    "starting",
+	phaseRollingBack: "rolling back",
+	phaseRolledBack:  "rolled back",
+	phaseStarted:     "started",
+	phaseStopping:    "stopping",
+	phaseStopped:     "stopped",
+}
+
+// debugSlowest is the number of slowest hooks kept for debug reports.
+const debugSlowest = 10
+
+// debugError is a failed event kept for debug reports.
+type debugError struct {
+	time  time.Time
+	event string
+	err   string
+}
+
+// debugState aggregates the lifecycle for DebugHandler.
+type debugState struct {
+	order     *orderValidator
+	timeline  *startupTimeline
+	slowStart *slowestHooks
+	slowStop  *slowestHooks
+	errors    []debugError // most recent last
+	maxErrors int
+}
+
+func newDebugState(maxErrors int) *debugState {
+	return &debugState{
+		order:     newOrderValidator(),
+		timeline:  newStartupTimeline(),
+		slowStart: &slowestHooks{n: debugSlowest},
+		slowStop:  &slowestHooks{n: debugSlowest},
+		maxErrors: maxErrors,
+	}
+}
+
+// observe records event, received at now.
+func (s *debugState) observe(event fxevent.Event, now time.Time) {
+	s.order.check(event)
+	s.timeline.observe(event, now)
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		if e.Err == nil {
+			s.slowStart.observe(hookRuntime{callee: e.FunctionName, caller: e.CallerName, runtime: e.Runtime})
+		}
+	case *fxevent.OnStopExecuted:
+		if e.Err == nil {
+			s.slowStop.observe(hookRuntime{callee: e.FunctionName, caller: e.CallerName, runtime: e.Runtime})
+		}
+	}
+	if err := eventError(event); err != nil && s.maxErrors > 0 {
+		if len(s.errors) == s.maxErrors {
+			s.errors = s.errors[1:]
+		}
+		s.errors = append(s.errors, debugError{time: now, event: eventName(event), err: err.Error()})
+	}
+}
+
+// debugHook is a hook in a debug report.
+type debugHook struct {
+	Callee  string        `json:"callee"`
+	Caller  string        `json:"caller"`
+	Offset  time.Duration `json:"offset,omitempty"` // since the first event, in the timeline
+	Runtime time.Duration `json:"runtime"`
+}
+
+// debugReport is the state rendered by DebugHandler.
+type debugReport struct {
+	Phase        string        `json:"phase"`
+	Events       int           `json:"events"`
+	Errors       int           `json:"errors"`
+	First        time.Time     `json:"first,omitzero"`
+	Last         time.Time     `json:"last,omitzero"`
+	Timeline     []debugHook   `json:"timeline"`
+	SlowestStart []debugHook   `json:"slowest_start"`
+	SlowestStop  []debugHook   `json:"slowest_stop"`
+	RecentErrors []debugRecent `json:"recent_errors"`
+}
+
+// debugRecent is a recent error in a debug report.
+type debugRecent struct {
+	Time  time.Time `json:"time"`
+	Event string    `json:"event"`
+	Error string    `json:"error"`
+}
+
+// report returns the debug report of the state and stats.
+func (s *debugState) report(stats Stats) debugReport {
+	r := debugReport{
+		Phase:        phaseNames[s.order.phase],
+		First:        stats.First,
+		Last:         stats.Last,
+		Timeline:     []debugHook{},
+		SlowestStart: debugHooks(s.slowStart.hooks),
+		SlowestStop:  debugHooks(s.slowStop.hooks),
+		RecentErrors: make([]debugRecent, len(s.errors)),
+	}
+	for _, n := range stats.Events {
+		r.Events += n
+	}
+	for _, n := range stats.Errors {
+		r.Errors += n
+	}
+	for _, span := range s.timeline.spans {
+		r.Timeline = append(r.Timeline, debugHook{Callee: span.callee, Caller: span.caller, Offset: span.offset, Runtime: span.runtime})
+	}
+	// Most recent first.
+	for i, e := range s.errors {
+		r.RecentErrors[len(s.errors)-1-i] = debugRecent{Time: e.time, Event: e.event, Error: e.err}
+	}
+	return r
+}
+
+func debugHooks(hooks []hookRuntime) []debugHook {
+	out := make([]debugHook, len(hooks))
+	for i, h := range hooks {
+		out[i] = debugHook{Callee: h.callee, Caller: h.caller, Runtime: h.runtime}
+	}
+	return out
+}
+
+// DebugHandler returns an http.Handler, typically mounted at /debug/fx, that
+// renders the lifecycle as the Logger has observed it: the current phase,
+// event counts, the startup timeline, the slowest OnStart and OnStop hooks
+// and the most recent errors. It renders plain text, or JSON with
+// ?format=json. The state is only recorded with WithDebugState; without
+// it, the handler responds 404 Not Found.
+func (l *Logger) DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		stats := l.Stats()
+		l.mu.Lock()
+		if l.debug == nil {
+			l.mu.Unlock()
+			http.Error(w, "lifecycle state is not recorded, see WithDebugState", http.StatusNotFound)
+			return
+		}
+		r := l.debug.report(stats)
+		l.mu.Unlock()
+
+		if req.URL.Query().Get("format") == "json" {
+			w.Header().Set("Content-Type", "application/json")
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			enc.Encode(r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		writeDebugReport(w, r)
+	})
+}
+
+// writeDebugReport renders r as plain text.
+func writeDebugReport(w io.Writer, r debugReport) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "phase:\t%s\n", r.Phase)
+	fmt.Fprintf(tw, "events:\t%d (%d errors)\n", r.Events, r.Errors)
+	if !r.First.IsZero() {
+		fmt.Fprintf(tw, "first event:\t%s\n", r.First.Format(time.RFC3339Nano))
+		fmt.Fprintf(tw, "latest event:\t%s\n", r.Last.Format(time.RFC3339Nano))
+	}
+
+	fmt.Fprintf(tw, "\nstartup timeline:\n")
+	for _, h := range r.Timeline {
+		fmt.Fprintf(tw, "  +%s\t%s\t%s\t%s\n", roundedDuration(h.Offset), roundedDuration(h.Runtime), h.Callee, h.Caller)
+	}
+	for _, s := range []struct {
+		title string
+		hooks []debugHook
+	}{
+		{"slowest OnStart hooks", r.SlowestStart},
+		{"slowest OnStop hooks", r.SlowestStop},
+	} {
+		fmt.Fprintf(tw, "\n%s:\n", s.title)
+		for _, h := range s.hooks {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", roundedDuration(h.Runtime), h.Callee, h.Caller)
+		}
+	}
+	fmt.Fprintf(tw, "\nrecent errors:\n")
+	for _, e := range r.RecentErrors {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", e.Time.Format(time.RFC3339Nano), e.Event, e.Error)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func debugEvents(logger *Logger) {
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.first()", Err: errors.New("oldest failure")})
+	logger.LogEvent(&fxevent.OnStartExecuting{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: 1234567 * time.Nanosecond})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.second()", Err: errors.New("second")})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.third()", Err: errors.New("third")})
+	logger.LogEvent(&fxevent.Started{})
+}
+
+func TestDebugHandler(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithDebugState(2))
+	debugEvents(logger)
+
+	rec := httptest.NewRecorder()
+	logger.DebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/fx", nil))
+	out := rec.Body.String()
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Expected plain text, got %s", ct)
+	}
+	for _, want := range []string{"phase:", "started", "events:", "7 (3 errors)", "startup timeline:", "1.23ms", "main.start()", "slowest OnStart hooks:", "recent errors:", "third", "second"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in %s", want, out)
+		}
+	}
+	if strings.Contains(out, "oldest failure") {
+		t.Errorf("Expected only the 2 most recent errors, got %s", out)
+	}
+	if strings.Index(out, "third") > strings.Index(out, "second") {
+		t.Errorf("Expected the most recent error first, got %s", out)
+	}
+}
+
+func TestDebugHandler_JSON(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithDebugState(10))
+	debugEvents(logger)
+
+	rec := httptest.NewRecorder()
+	logger.DebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/fx?format=json", nil))
+	var r debugReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &r); err != nil {
+		t.Fatalf("Expected JSON, got %v: %s", err, rec.Body.String())
+	}
+	if r.Phase != "started" || r.Events != 7 || r.Errors != 3 || len(r.RecentErrors) != 3 {
+		t.Errorf("Expected the recorded state, got %+v", r)
+	}
+	if len(r.Timeline) != 1 || r.Timeline[0].Runtime != 1234567*time.Nanosecond || len(r.SlowestStart) != 1 {
+		t.Errorf("Expected the hook timeline, got %+v", r)
+	}
+}
+
+func TestDebugHandler_NotRecorded(t *testing.T) {
+	logger, _ := newTestLoggerWith()
+	rec := httptest.NewRecorder()
+	logger.DebugHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/fx", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 without WithDebugState, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"io"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/diode"
+)
+
+// diodePollInterval is how often the diode writer checks for new records.
+const diodePollInterval = 10 * time.Millisecond
+
+// NewNonBlocking creates a Logger that writes to w through a zerolog diode
+// buffer of size records, so lifecycle logging never blocks the application
+// even when w stalls. Records that are overwritten before they can be
+// written are counted in Stats.WriterDrops and reported with a
+// KindRecordsDropped warning, or with the next record if the Logger is busy
+// when the drop is noticed. Close the Logger to flush the buffer.
+func NewNonBlocking(w io.Writer, size int, opts ...Option) *Logger {
+	var l *Logger
+	dw := diode.NewWriter(w, size, diodePollInterval, func(missed int) {
+		l.stats.writerDropped(missed)
+		l.unreported.Add(int64(missed))
+		// The diode must not wait for the lock while LogEvent keeps writing
+		// to it, so a busy Logger reports the drop itself.
+		if l.mu.TryLock() {
+			l.reportDropped()
+			l.mu.Unlock()
+		}
+	})
+	logger := zerolog.New(dw)
+	l = New(&logger, opts...).(*Logger)
+	l.closer = dw
+	return l
+}
+
+// reportDropped logs a warning for the records dropped by the non-blocking
+// writer that have not been reported yet, if any. It must be called with
+// l.mu held.
+func (l *Logger) reportDropped() {
+	if n := l.unreported.Swap(0); n > 0 {
+		l.detached(zerolog.WarnLevel).Int("dropped", int(n)).Send(KindRecordsDropped)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestNewNonBlocking(t *testing.T) {
+	w := &blockingWriter{release: make(chan struct{})}
+	logger := NewNonBlocking(w, 4, WithoutStackTraces(), WithMessages(map[EventKind]string{KindRecordsDropped: "records lost"}))
+
+	done := make(chan struct{})
+	go func() {
+		for range 100 {
+			logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+		}
+		logger.LogEvent(&fxevent.Started{})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected LogEvent not to block on a stalled writer")
+	}
+
+	close(w.release)
+	if err := logger.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(w.String(), "started") {
+		t.Errorf("Expected the latest record to be written, got %s", w.String())
+	}
+	if logger.Stats().WriterDrops == 0 {
+		t.Error("Expected dropped records to be counted")
+	}
+	if !strings.Contains(w.String(), `"message":"records lost"`) {
+		t.Errorf("Expected a drop warning, got %s", w.String())
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxeventzerolog implements the fxevent.Logger interface with
+// zerolog, so that Fx lifecycle events are logged as structured records:
+//
+//	fx.WithLogger(func(logger *zerolog.Logger) fxevent.Logger {
+//		return fxeventzerolog.New(logger)
+//	})
+//
+// # Schemas
+//
+// PresetCompact, PresetGCP, PresetDatadog, PresetOTel, PresetSplunk,
+// PresetGELF, WithECSFields and WithLokiFields each rewrite records into the
+// conventions of a backend, their schema. A Logger has a single schema, so
+// these options do not combine: only the last one given applies. Options
+// that choose what is logged, such as PresetProduction, combine with any of
+// them.
+package fxeventzerolog
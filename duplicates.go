@@ -0,0 +1,33 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// checkDuplicates logs a warning for each output type of e that was already
+// provided by a different constructor.
+func (l *Logger) checkDuplicates(e *fxevent.Provided) {
+	if l.providers == nil || e.Err != nil {
+		return
+	}
+	for _, t := range e.OutputTypeNames {
+		prev, ok := l.providers[t]
+		if !ok {
+			l.providers[t] = e.ConstructorName
+			continue
+		}
+		if prev == e.ConstructorName {
+			continue
+		}
+		event := l.at(zerolog.WarnLevel).
+			Str("type", t).
+			Str("constructor", e.ConstructorName).
+			Str("previous_constructor", prev)
+		event = moduleName(event, e.ModuleName)
+		event.Send(KindDuplicateProvider)
+	}
+}
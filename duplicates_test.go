@@ -0,0 +1,35 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithDuplicateProvideWarnings(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDuplicateProvideWarnings())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "a.New()", OutputTypeNames: []string{"*db.Conn"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "b.New()", OutputTypeNames: []string{"*db.Conn", "*b.Other"}, ModuleName: "b"})
+	logger.LogEvent(&fxevent.Decorated{DecoratorName: "c.Wrap()", OutputTypeNames: []string{"*db.Conn"}})
+	out := buf.String()
+	if got := strings.Count(out, "type provided by multiple constructors"); got != 1 {
+		t.Fatalf("Expected 1 duplicate warning, got %d: %s", got, out)
+	}
+	want := `{"level":"warn","type":"*db.Conn","constructor":"b.New()","previous_constructor":"a.New()","module":"b"`
+	if !strings.Contains(out, want) {
+		t.Errorf("Expected warning %s, got %s", want, out)
+	}
+}
+
+func TestDuplicateProvideWarnings_DefaultOff(t *testing.T) {
+	logger, buf := newTestLogger()
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "a.New()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "b.New()", OutputTypeNames: []string{"T"}})
+	if strings.Contains(buf.String(), "multiple constructors") {
+		t.Error("Expected no duplicate warnings by default")
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// fieldKind identifies the type of value held by a field.
+type fieldKind uint8
+
+const (
+	stringField fieldKind = iota
+	stringsField
+	boolField
+	intField
+	floatField
+	durationField
+	errorField
+	objectField
+	arrayField
+)
+
+// field is a single key/value pair of an entry.
+type field struct {
+	key  string
+	kind fieldKind
+	str  string
+	strs []string
+	num  int64
+	flt  float64
+	err  error
+	sub  []field // fields of a nested object, or objects of an array
+}
+
+// equal reports whether f and o hold the same key and value.
+func (f field) equal(o field) bool {
+	if f.key != o.key || f.kind != o.kind {
+		return false
+	}
+	switch f.kind {
+	case stringField:
+		return f.str == o.str
+	case stringsField:
+		return slices.Equal(f.strs, o.strs)
+	case errorField:
+		return f.err == o.err || (f.err != nil && o.err != nil && f.err.Error() == o.err.Error())
+	case floatField:
+		return f.flt == o.flt
+	case objectField, arrayField:
+		return slices.EqualFunc(f.sub, o.sub, field.equal)
+	default:
+		return f.num == o.num
+	}
+}
+
+// entry is a log record under construction. Its methods mirror the subset of
+// zerolog.Event used by LogEvent, so records can be inspected and rewritten
+// before they are encoded to the underlying zerolog.Logger.
+type entry struct {
+	l      *Logger
+	level  zerolog.Level
+	event  fxevent.Event // event the entry was created for, if any
+	msg    string
+	fields []field
+
+	// envelope is set when the fields already carry the level and message,
+	// so they are written without zerolog's own.
+	envelope bool
+	// out is the zerolog logger the finished entry is written to, chosen
+	// when it is finished so that it is unaffected by a later logger swap.
+	out *zerolog.Logger
+	// disabled is set when no output writes the entry's level, so its
+	// fields are not collected.
+	disabled bool
+	// tmpl is the template msg was rendered from, if any. It is rendered
+	// again once the entry is finished, so that the message shows names
+	// as redacted, hashed and shortened in the fields.
+	tmpl messageTemplate
+}
+
+// entryPool holds released entries, so that their fields are reused.
+var entryPool = sync.Pool{
+	New: func() any {
+		return &entry{fields: make([]field, 0, 8)}
+	},
+}
+
+// maxPooledFields is the capacity above which entries are not pooled.
+const maxPooledFields = 64
+
+// newEntry returns an empty entry from the pool.
+func newEntry(l *Logger, lvl zerolog.Level, event fxevent.Event) *entry {
+	e := entryPool.Get().(*entry)
+	e.l, e.level, e.event = l, lvl, event
+	return e
+}
+
+// release returns the finished entry to the pool. It must not be used
+// afterwards.
+func (e *entry) release() {
+	if e.disabled || cap(e.fields) > maxPooledFields {
+		return
+	}
+	clear(e.fields)
+	*e = entry{fields: e.fields[:0]}
+	entryPool.Put(e)
+}
+
+// Str adds a string field.
+func (e *entry) Str(key, val string) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: stringField, str: val})
+	return e
+}
+
+// Strs adds a string slice field.
+func (e *entry) Strs(key string, vals []string) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: stringsField, strs: vals})
+	return e
+}
+
+// Bool adds a boolean field.
+func (e *entry) Bool(key string, b bool) *entry {
+	if e.disabled {
+		return e
+	}
+	var n int64
+	if b {
+		n = 1
+	}
+	e.fields = append(e.fields, field{key: key, kind: boolField, num: n})
+	return e
+}
+
+// Int adds an integer field.
+func (e *entry) Int(key string, i int) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: intField, num: int64(i)})
+	return e
+}
+
+// Float adds a floating-point field.
+func (e *entry) Float(key string, f float64) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: floatField, flt: f})
+	return e
+}
+
+// Dur adds a duration field.
+func (e *entry) Dur(key string, d time.Duration) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: durationField, num: int64(d)})
+	return e
+}
+
+// Err adds an error field under zerolog.ErrorFieldName.
+func (e *entry) Err(err error) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: zerolog.ErrorFieldName, kind: errorField, err: err})
+	return e
+}
+
+// Dict adds a nested object holding the fields of sub.
+func (e *entry) Dict(key string, sub *entry) *entry {
+	if e.disabled {
+		return e
+	}
+	e.fields = append(e.fields, field{key: key, kind: objectField, sub: sub.fields})
+	return e
+}
+
+// Dicts adds an array of nested objects holding the fields of subs.
+func (e *entry) Dicts(key string, subs []*entry) *entry {
+	if e.disabled {
+		return e
+	}
+	objs := make([]field, len(subs))
+	for i, sub := range subs {
+		objs[i] = field{kind: objectField, sub: sub.fields}
+	}
+	e.fields = append(e.fields, field{key: key, kind: arrayField, sub: objs})
+	return e
+}
+
+// clone returns a copy of the unfinished entry, so records sharing most of
+// their fields are only built once.
+func (e *entry) clone() *entry {
+	if e.disabled {
+		return e
+	}
+	c := newEntry(e.l, e.level, e.event)
+	c.fields = append(c.fields, e.fields...)
+	return c
+}
+
+// Msg completes the entry with the given message and hands it to the logger.
+func (e *entry) Msg(msg string) {
+	e.msg = msg
+	e.l.emit(e)
+}
+
+// MsgTemplate completes the entry with the message rendered from t and hands
+// it to the logger.
+func (e *entry) MsgTemplate(t messageTemplate) {
+	if !e.disabled {
+		e.tmpl = t
+		e.msg = t.render(e.fields)
+	}
+	e.l.emit(e)
+}
+
+// sameAs reports whether e and o have the same level, message and fields,
+// ignoring the runtime field.
+func (e *entry) sameAs(o *entry) bool {
+	if e.level != o.level || e.msg != o.msg {
+		return false
+	}
+	a, b := e.fieldsExcept("runtime"), o.fieldsExcept("runtime")
+	return slices.EqualFunc(a, b, field.equal)
+}
+
+// fieldsExcept returns the fields of e whose key is not key.
+func (e *entry) fieldsExcept(key string) []field {
+	return slices.DeleteFunc(slices.Clone(e.fields), func(f field) bool {
+		return f.key == key
+	})
+}
+
+// write finishes the entry and encodes it, or queues it for the async
+// writer.
+func (e *entry) write() {
+	// Names are redacted and hashed before they are truncated, so that
+	// patterns match and hashes differ whatever the limits.
+	if len(e.l.redacted) > 0 {
+		e.l.redactTypes(e)
+	}
+	if e.l.hasher != nil {
+		e.l.hashNames(e)
+	}
+	if e.l.limits != nil {
+		e.l.limits.limitFields(e.fields)
+	}
+	if e.l.minimal {
+		minimizeFields(e)
+	}
+	if e.tmpl != nil {
+		e.msg = e.tmpl.render(e.fields)
+	}
+	if e.l.traceIDs != nil {
+		traceFields(e)
+	}
+	if e.l.syslog {
+		e.level = syslogLevel(e)
+	}
+	if e.l.schema != nil {
+		e.l.schema(e)
+	}
+	if e.l.journald {
+		journaldFields(e)
+	}
+	if e.l.allowedFields != nil || e.l.deniedFields != nil {
+		e.l.filterFields(e)
+	}
+	e.out = e.l.output(e.level)
+	if len(e.l.onLogged) > 0 && writes(e.out, e.level) {
+		e.l.logged = append(e.l.logged, loggedRecord{event: e.event, level: e.level})
+	}
+	if e.l.async == nil || !e.l.async.push(e) {
+		e.encode()
+		e.release()
+	}
+}
+
+// encode writes the finished entry to the sink or zerolog logger, the
+// console, the sinks of WithTeeSink, slog and the stream clients.
+func (e *entry) encode() {
+	if e.l.sink != nil {
+		e.l.sink.Write(e.record())
+	} else if e.l.consoleOut {
+		e.encodeConsole(e.out)
+	} else if !e.envelope {
+		encodeFields(e.out.WithLevel(e.level), e.fields).Msg(e.msg)
+	} else if writes(e.out, e.level) {
+		encodeFields(e.out.Log(), e.fields).Send()
+	}
+	if e.l.console != nil {
+		e.encodeConsole(e.l.console)
+	}
+	e.writeTees()
+	e.emitSlog()
+	if e.l.stream != nil {
+		e.publishStream()
+	}
+}
+
+// encodeFields adds fields to the zerolog event.
+func encodeFields(ev *zerolog.Event, fields []field) *zerolog.Event {
+	for _, f := range fields {
+		switch f.kind {
+		case stringField:
+			ev = ev.Str(f.key, f.str)
+		case stringsField:
+			ev = ev.Strs(f.key, f.strs)
+		case boolField:
+			ev = ev.Bool(f.key, f.num != 0)
+		case intField:
+			ev = ev.Int64(f.key, f.num)
+		case floatField:
+			ev = ev.Float64(f.key, f.flt)
+		case durationField:
+			ev = ev.Str(f.key, time.Duration(f.num).String())
+		case errorField:
+			if f.key == zerolog.ErrorFieldName {
+				ev = ev.Err(f.err)
+			} else {
+				ev = ev.AnErr(f.key, f.err)
+			}
+		case objectField:
+			ev = ev.Dict(f.key, encodeFields(zerolog.Dict(), f.sub))
+		case arrayField:
+			arr := zerolog.Arr()
+			for _, obj := range f.sub {
+				arr = arr.Dict(encodeFields(zerolog.Dict(), obj.sub))
+			}
+			ev = ev.Array(f.key, arr)
+		}
+	}
+	return ev
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEntry_SameAs(t *testing.T) {
+	logger, _ := newTestLogger()
+	a := logger.log().Str("name", "n").Strs("trace", []string{"t"}).Dur("runtime", 1).Err(errors.New("x"))
+	b := logger.log().Str("name", "n").Strs("trace", []string{"t"}).Dur("runtime", 2).Err(errors.New("x"))
+	if !a.sameAs(b) {
+		t.Error("Expected entries differing only in runtime to be the same")
+	}
+	c := logger.log().Str("name", "other").Strs("trace", []string{"t"}).Dur("runtime", 1).Err(errors.New("x"))
+	if a.sameAs(c) {
+		t.Error("Expected entries with different fields to differ")
+	}
+	if a.sameAs(logger.err().Str("name", "n").Strs("trace", []string{"t"}).Err(errors.New("x"))) {
+		t.Error("Expected entries with different levels to differ")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"reflect"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// eventName returns the name of the event's type, e.g. "OnStartExecuted".
+func eventName(event fxevent.Event) string {
+	t := reflect.TypeOf(event)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// eventError returns the error carried by event, if any.
+func eventError(event fxevent.Event) error {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		return e.Err
+	case *fxevent.OnStopExecuted:
+		return e.Err
+	case *fxevent.Supplied:
+		return e.Err
+	case *fxevent.Provided:
+		return e.Err
+	case *fxevent.Replaced:
+		return e.Err
+	case *fxevent.Decorated:
+		return e.Err
+	case *fxevent.Run:
+		return e.Err
+	case *fxevent.Invoked:
+		return e.Err
+	case *fxevent.Started:
+		return e.Err
+	case *fxevent.Stopped:
+		return e.Err
+	case *fxevent.RollingBack:
+		return e.StartErr
+	case *fxevent.RolledBack:
+		return e.Err
+	case *fxevent.LoggerInitialized:
+		return e.Err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "go.uber.org/fx/fxevent"
+
+// notifyFailure calls the failure handlers if event is a lifecycle failure:
+// RollingBack, or Started or Stopped with an error.
+func (l *Logger) notifyFailure(event fxevent.Event) {
+	if len(l.onFailure) == 0 {
+		return
+	}
+	var err error
+	switch e := event.(type) {
+	case *fxevent.RollingBack:
+		err = e.StartErr
+	case *fxevent.Started:
+		err = e.Err
+	case *fxevent.Stopped:
+		err = e.Err
+	}
+	if err == nil {
+		return
+	}
+	for _, h := range l.onFailure {
+		h(event, err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithFailureHandler(t *testing.T) {
+	var got []string
+	var logger fxevent.Logger
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	logger = New(&zl, WithFailureHandler(func(event fxevent.Event, err error) {
+		if !strings.Contains(buf.String(), err.Error()) {
+			t.Error("Expected the failure to be logged before the handler runs")
+		}
+		// The handler may use the logger without deadlocking.
+		logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+		got = append(got, eventName(event)+": "+err.Error())
+	}))
+
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("hook")})
+	logger.LogEvent(&fxevent.RollingBack{StartErr: errors.New("rollback")})
+	logger.LogEvent(&fxevent.RolledBack{})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("start")})
+	logger.LogEvent(&fxevent.Stopped{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("stop")})
+
+	want := []string{"RollingBack: rollback", "Started: start", "Stopped: stop"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected handler calls %v, got %v", want, got)
+	}
+}
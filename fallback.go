@@ -0,0 +1,22 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// WrapWithFallback creates a Logger that writes to logger, configured by the
+// given options, and forwards every event it produces no record for to
+// primary. That covers event types this package does not know and events
+// dropped by its options, such as fx internals, sampled or rate-limited
+// events. This allows a gradual migration from another fxevent.Logger, such
+// as fxevent.ZapLogger, which keeps handling whatever is not yet logged here.
+// Forwarded events are passed to primary after the Logger's lock is released.
+func WrapWithFallback(primary fxevent.Logger, logger *zerolog.Logger, opts ...Option) *Logger {
+	l := New(logger, opts...).(*Logger)
+	l.fallback = primary
+	return l
+}
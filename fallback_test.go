@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWrapWithFallback(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	primary := &eventRecorder{}
+	logger := WrapWithFallback(primary, &zl, WithoutFxInternals())
+
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}})
+	logger.LogEvent(&fxevent.Replaced{OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Started{})
+
+	if len(primary.events) != 2 {
+		t.Fatalf("Expected 2 forwarded events, got %d", len(primary.events))
+	}
+	if e, ok := primary.events[0].(*fxevent.Provided); !ok || e.ConstructorName != "go.uber.org/fx.New.func1()" {
+		t.Errorf("Expected the filtered fx internal to be forwarded, got %#v", primary.events[0])
+	}
+	if _, ok := primary.events[1].(*fxevent.Replaced); !ok {
+		t.Errorf("Expected the unhandled Replaced event to be forwarded, got %#v", primary.events[1])
+	}
+	if !strings.Contains(buf.String(), "main.New()") || !strings.Contains(buf.String(), "started") {
+		t.Errorf("Expected handled events to be logged, got %s", buf.String())
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+	"go.uber.org/fx/fxtest"
+)
+
+// New creates an fxtest.App from opts whose lifecycle is logged through
+// NewTestLogger in Strict mode, so any event carrying an error fails the
+// test, and recorded by the returned Recorder:
+//
+//	app, rec := fxeventtest.New(t, fx.Provide(NewServer), fx.Invoke(Register))
+//	app.RequireStart().RequireStop()
+//	rec.RequireStarted(t)
+//
+// opts must not include fx.WithLogger.
+func New(t testing.TB, opts ...fx.Option) (*fxtest.App, *Recorder) {
+	rec := NewRecorder()
+	logger := fxeventzerolog.Tee(NewTestLogger(t, Strict(t)), rec)
+	opts = append(opts, fx.WithLogger(func() fxevent.Logger { return logger }))
+	return fxtest.New(t, opts...), rec
+}
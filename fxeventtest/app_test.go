@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestNew(t *testing.T) {
+	app, rec := New(t, fx.Invoke(func(lc fx.Lifecycle) {
+		lc.Append(fx.StartHook(func() {}))
+	}))
+	app.RequireStart().RequireStop()
+
+	rec.RequireStarted(t)
+	rec.RequireStopped(t)
+	if len(rec.HookDurations()) != 1 {
+		t.Errorf("Expected the hook to be recorded, got %v", rec.HookDurations())
+	}
+}
+
+func TestNew_Strict(t *testing.T) {
+	rec := &errorRecorder{logRecorder: logRecorder{TB: t}}
+	app, events := New(rec, fx.Invoke(func(lc fx.Lifecycle) {
+		lc.Append(fx.StartHook(func() error { return errors.New("boom") }))
+	}))
+	if err := app.Start(context.Background()); err == nil {
+		t.Fatal("Expected the application to fail to start")
+	}
+
+	if len(rec.errors) == 0 || !strings.Contains(rec.errors[0], "OnStartExecuted failed: boom") {
+		t.Errorf("Expected the failed hook to fail the test, got %q", rec.errors)
+	}
+	if len(events.ErrorsOf(&fxevent.Started{})) != 1 {
+		t.Errorf("Expected the failed start to be recorded, got %v", events.Events())
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+)
+
+// Field returns the value of the field key of r. A key that is not a
+// top-level field is looked up as a dotted path into nested objects, such
+// as "error.message".
+func Field(r fxeventzerolog.Record, key string) (any, bool) {
+	return field(r.Fields, key)
+}
+
+// field returns the value of the field key of fields, looking it up as a
+// dotted path if it is not found.
+func field(fields []fxeventzerolog.Field, key string) (any, bool) {
+	for _, f := range fields {
+		if f.Key == key {
+			return f.Value, true
+		}
+	}
+	head, rest, ok := strings.Cut(key, ".")
+	if !ok {
+		return nil, false
+	}
+	for _, f := range fields {
+		if sub, ok := f.Value.([]fxeventzerolog.Field); ok && f.Key == head {
+			return field(sub, rest)
+		}
+	}
+	return nil, false
+}
+
+// AssertField reports a test failure unless r has the field key holding
+// want. Integers of any type compare by value, and an error field matches a
+// string holding its message.
+func AssertField(t testing.TB, r fxeventzerolog.Record, key string, want any) bool {
+	t.Helper()
+	got, ok := Field(r, key)
+	if !ok {
+		t.Errorf("record %q has no field %q", r.Message, key)
+		return false
+	}
+	if !fieldEqual(got, want) {
+		t.Errorf("record %q field %q = %v (%T), want %v (%T)", r.Message, key, got, got, want, want)
+		return false
+	}
+	return true
+}
+
+// AssertNoField reports a test failure if r has the field key.
+func AssertNoField(t testing.TB, r fxeventzerolog.Record, key string) bool {
+	t.Helper()
+	if got, ok := Field(r, key); ok {
+		t.Errorf("record %q has unexpected field %q = %v", r.Message, key, got)
+		return false
+	}
+	return true
+}
+
+// fieldEqual reports whether the field value got matches want.
+func fieldEqual(got, want any) bool {
+	if err, ok := got.(error); ok {
+		if s, ok := want.(string); ok {
+			return err.Error() == s
+		}
+	}
+	g, w := reflect.ValueOf(got), reflect.ValueOf(want)
+	if g.CanInt() && w.CanInt() {
+		return g.Int() == w.Int()
+	}
+	return reflect.DeepEqual(got, want)
+}
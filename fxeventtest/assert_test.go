@@ -0,0 +1,32 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"errors"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestAssertField(t *testing.T) {
+	logger, logs := NewObserved(fxeventzerolog.WithECSFields())
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", ModuleName: "db", Err: errors.New("boom")})
+	r := logs.All()[0]
+
+	AssertField(t, r, "module", "db")
+	AssertField(t, r, "error.message", "boom")
+	AssertNoField(t, r, "stacktrace")
+
+	rec := &failureRecorder{TB: t}
+	if AssertField(rec, r, "module", "web") || AssertField(rec, r, "missing", 1) || AssertNoField(rec, r, "module") {
+		t.Errorf("Expected mismatches to be reported, last got %q", rec.failure)
+	}
+}
+
+func TestAssertField_Ints(t *testing.T) {
+	r := fxeventzerolog.Record{Fields: []fxeventzerolog.Field{{Key: "hooks", Value: int64(2)}}}
+	AssertField(t, r, "hooks", 2)
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"testing"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestFakeClock(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	logger, logs := NewObserved(fxeventzerolog.WithClock(clock), fxeventzerolog.WithQuietStartup())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	clock.Add(3 * time.Second)
+	logger.LogEvent(&fxevent.Started{})
+
+	if logs.FilterMessage("started").FilterField("duration", 3*time.Second).Len() != 1 {
+		t.Errorf("Expected a startup duration from the fake clock, got %+v", logs.All())
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"runtime/debug"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// ConformanceCase is an event fed through a logger by RunLoggerConformance,
+// with the messages its output must contain.
+type ConformanceCase struct {
+	Name     string
+	Event    fxevent.Event
+	Messages []string
+}
+
+// errConformance is the error carried by the failing conformance events.
+var errConformance = errors.New("conformance error")
+
+// ConformanceCases returns the cases run by RunLoggerConformance: every
+// fxevent type, successful and failing, with and without a module, and
+// with empty fields. Messages are those logged by fxeventzerolog.New
+// without options.
+func ConformanceCases() []ConformanceCase {
+	return []ConformanceCase{
+		{"OnStartExecuting", &fxevent.OnStartExecuting{FunctionName: "main.start", CallerName: "main.run"}, []string{"OnStart hook executing"}},
+		{"OnStartExecuted", &fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Method: "OnStart", Runtime: time.Millisecond}, []string{"OnStart hook executed"}},
+		{"OnStartExecuted/Err", &fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Err: errConformance}, []string{"OnStart hook failed", errConformance.Error()}},
+		{"OnStopExecuting", &fxevent.OnStopExecuting{FunctionName: "main.stop", CallerName: "main.run"}, []string{"OnStop hook executing"}},
+		{"OnStopExecuted", &fxevent.OnStopExecuted{FunctionName: "main.stop", CallerName: "main.run", Runtime: time.Millisecond}, []string{"OnStop hook executed"}},
+		{"OnStopExecuted/Err", &fxevent.OnStopExecuted{FunctionName: "main.stop", CallerName: "main.run", Err: errConformance}, []string{"OnStop hook failed", errConformance.Error()}},
+		{"OnStopExecuted/Empty", &fxevent.OnStopExecuted{}, []string{"OnStop hook executed"}},
+		{"Supplied", &fxevent.Supplied{TypeName: "*main.Config", ModuleName: "config"}, []string{"supplied", "*main.Config"}},
+		{"Supplied/NoModule", &fxevent.Supplied{TypeName: "*main.Config"}, []string{"supplied"}},
+		{"Supplied/Err", &fxevent.Supplied{TypeName: "*main.Config", Err: errConformance}, []string{"error encountered while applying options"}},
+		{"Provided", &fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server", "http.Handler"}, ModuleName: "server", StackTrace: []string{"main.go:10"}, ModuleTrace: []string{"main.go:5"}}, []string{"provided", "*main.Server", "http.Handler"}},
+		{"Provided/Private", &fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, Private: true}, []string{"provided"}},
+		{"Provided/NoTypes", &fxevent.Provided{ConstructorName: "main.NewServer()"}, nil},
+		{"Provided/Err", &fxevent.Provided{ConstructorName: "main.NewServer()", Err: errConformance}, []string{"error encountered while applying options"}},
+		{"Replaced", &fxevent.Replaced{OutputTypeNames: []string{"*main.Server"}}, nil},
+		{"Replaced/Err", &fxevent.Replaced{Err: errConformance}, nil},
+		{"Decorated", &fxevent.Decorated{DecoratorName: "main.Decorate()", OutputTypeNames: []string{"*main.Server"}, ModuleName: "server"}, []string{"decorated"}},
+		{"Decorated/Err", &fxevent.Decorated{DecoratorName: "main.Decorate()", Err: errConformance}, []string{"error encountered while applying options"}},
+		{"Run", &fxevent.Run{Name: "main.NewServer()", Kind: "provide", Runtime: time.Millisecond}, []string{"run"}},
+		{"Run/Err", &fxevent.Run{Name: "main.NewServer()", Kind: "provide", ModuleName: "server", Err: errConformance}, []string{"error returned"}},
+		{"Invoking", &fxevent.Invoking{FunctionName: "main.Register()", ModuleName: "server"}, []string{"invoking"}},
+		{"Invoked", &fxevent.Invoked{FunctionName: "main.Register()"}, nil},
+		{"Invoked/Err", &fxevent.Invoked{FunctionName: "main.Register()", Trace: "main.go:20", Err: errConformance}, []string{"invoke failed"}},
+		{"Stopping", &fxevent.Stopping{Signal: os.Interrupt}, []string{"received signal", "INTERRUPT"}},
+		{"Stopped", &fxevent.Stopped{}, nil},
+		{"Stopped/Err", &fxevent.Stopped{Err: errConformance}, []string{"stop failed"}},
+		{"RollingBack", &fxevent.RollingBack{StartErr: errConformance}, []string{"start failed, rolling back"}},
+		{"RollingBack/NilErr", &fxevent.RollingBack{}, []string{"start failed, rolling back"}},
+		{"RolledBack", &fxevent.RolledBack{}, nil},
+		{"RolledBack/Err", &fxevent.RolledBack{Err: errConformance}, []string{"rollback failed"}},
+		{"Started", &fxevent.Started{}, []string{"started"}},
+		{"Started/Err", &fxevent.Started{Err: errConformance}, []string{"start failed"}},
+		{"LoggerInitialized", &fxevent.LoggerInitialized{ConstructorName: "main.NewLogger()"}, []string{"initialized custom fxevent.Logger"}},
+		{"LoggerInitialized/Err", &fxevent.LoggerInitialized{Err: errConformance}, []string{"custom logger initialization failed"}},
+	}
+}
+
+// RunLoggerConformance runs a subtest per ConformanceCases case, feeding
+// its event through a logger created by factory to write to a buffer, and
+// fails it if the logger panics or the output lacks the case's messages.
+// Teams wrapping or extending fxeventzerolog.Logger can run it against
+// their own logger:
+//
+//	fxeventtest.RunLoggerConformance(t, func(w io.Writer) fxevent.Logger {
+//		logger := zerolog.New(w)
+//		return mylog.Wrap(fxeventzerolog.New(&logger))
+//	})
+func RunLoggerConformance(t *testing.T, factory func(w io.Writer) fxevent.Logger) {
+	t.Helper()
+	for _, c := range ConformanceCases() {
+		t.Run(c.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := factory(&buf)
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Fatalf("LogEvent panicked: %v\n%s", r, debug.Stack())
+					}
+				}()
+				logger.LogEvent(c.Event)
+			}()
+			for _, msg := range c.Messages {
+				if !bytes.Contains(buf.Bytes(), []byte(msg)) {
+					t.Errorf("output does not contain %q: %s", msg, buf.String())
+				}
+			}
+		})
+	}
+}
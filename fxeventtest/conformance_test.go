@@ -0,0 +1,27 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"io"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestRunLoggerConformance(t *testing.T) {
+	RunLoggerConformance(t, func(w io.Writer) fxevent.Logger {
+		logger := zerolog.New(w)
+		return fxeventzerolog.New(&logger, fxeventzerolog.WithDecorations())
+	})
+}
+
+func TestRunLoggerConformance_Wrapped(t *testing.T) {
+	RunLoggerConformance(t, func(w io.Writer) fxevent.Logger {
+		logger := zerolog.New(w)
+		return fxeventzerolog.Tee(fxeventzerolog.New(&logger, fxeventzerolog.PresetVerbose()), NewRecorder())
+	})
+}
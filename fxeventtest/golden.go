@@ -0,0 +1,152 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// UpdateGoldenEnv is the environment variable that, when set to a non-empty
+// value, makes AssertGolden write golden files instead of comparing them.
+const UpdateGoldenEnv = "FXEVENTTEST_UPDATE"
+
+// Normalize rewrites JSON lifecycle output into a stable form for snapshot
+// tests: timestamps are removed, duration strings such as "1.2ms" become
+// "0s", string arrays such as the types of a Provided record are sorted and
+// object keys are sorted. Lines that are not JSON objects are kept as they
+// are.
+func Normalize(output []byte) []byte {
+	var out bytes.Buffer
+	for _, line := range bytes.SplitAfter(output, []byte("\n")) {
+		trimmed := bytes.TrimSpace(line)
+		if len(trimmed) == 0 {
+			continue
+		}
+		var record map[string]any
+		if json.Unmarshal(trimmed, &record) != nil {
+			out.Write(trimmed)
+			out.WriteByte('\n')
+			continue
+		}
+		delete(record, zerolog.TimestampFieldName)
+		normalized, err := json.Marshal(normalizeValue(record))
+		if err != nil {
+			out.Write(trimmed)
+		} else {
+			out.Write(normalized)
+		}
+		out.WriteByte('\n')
+	}
+	return out.Bytes()
+}
+
+// normalizeValue normalizes a decoded JSON value.
+func normalizeValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			v[k] = normalizeValue(val)
+		}
+		return v
+	case []any:
+		strs := true
+		for i, val := range v {
+			v[i] = normalizeValue(val)
+			_, ok := v[i].(string)
+			strs = strs && ok
+		}
+		if strs {
+			slices.SortFunc(v, func(a, b any) int {
+				return strings.Compare(a.(string), b.(string))
+			})
+		}
+		return v
+	case string:
+		if _, err := time.ParseDuration(v); err == nil && v != "0" {
+			return "0s"
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// AssertGolden normalizes output and compares it with the golden file at
+// path, reporting a line diff on mismatch. If the environment variable
+// named by UpdateGoldenEnv is set, the golden file is written instead:
+//
+//	FXEVENTTEST_UPDATE=1 go test ./...
+func AssertGolden(t testing.TB, path string, output []byte) {
+	t.Helper()
+	got := Normalize(output)
+	if len(os.Getenv(UpdateGoldenEnv)) > 0 {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating golden file directory: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("golden file %s does not exist; run with %s=1 to create it", path, UpdateGoldenEnv)
+	} else if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output does not match %s (-want +got):\n%s", path, diffLines(string(want), string(got)))
+	}
+}
+
+// diffLines returns a line diff of want and got, with removed lines
+// prefixed by "-", added lines by "+" and common lines by " ".
+func diffLines(want, got string) string {
+	a := strings.Split(strings.TrimSuffix(want, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(got, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i, j = i+1, j+1
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("- " + a[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+ " + b[j] + "\n")
+			j++
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestNormalize(t *testing.T) {
+	in := `{"level":"info","time":"2025-01-02T03:04:05Z","runtime":"1.5ms","type":["b","a"],"message":"provided"}
+not json
+`
+	want := `{"level":"info","message":"provided","runtime":"0s","type":["a","b"]}
+not json
+`
+	if got := string(Normalize([]byte(in))); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).With().Timestamp().Logger()
+	logger := fxeventzerolog.New(&zl)
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Started{})
+
+	path := filepath.Join(t.TempDir(), "testdata", "lifecycle.golden")
+	t.Setenv(UpdateGoldenEnv, "1")
+	AssertGolden(t, path, buf.Bytes())
+	t.Setenv(UpdateGoldenEnv, "")
+	AssertGolden(t, path, buf.Bytes())
+
+	rec := &failureRecorder{TB: t}
+	AssertGolden(rec, path, []byte(`{"message":"stopped"}`))
+	if !strings.Contains(rec.failure, `+ {"message":"stopped"}`) || !strings.Contains(rec.failure, `- {"callee":"main.start"`) {
+		t.Errorf("Expected a line diff, got %s", rec.failure)
+	}
+
+	golden, _ := os.ReadFile(path)
+	if strings.Contains(string(golden), `"time"`) || !strings.Contains(string(golden), `"runtime":"0s"`) {
+		t.Errorf("Expected a normalized golden file, got %s", golden)
+	}
+}
+
+// failureRecorder is a testing.TB that keeps the last failure reported
+// through Errorf.
+type failureRecorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Errorf(format string, args ...any) {
+	r.failure = fmt.Sprintf(format, args...)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"reflect"
+	"sync"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+)
+
+// NewObserved returns a Logger configured by opts whose records are captured
+// in memory, together with the store they are captured in, so tests can
+// assert on exactly what would be written.
+func NewObserved(opts ...fxeventzerolog.Option) (*fxeventzerolog.Logger, *ObservedLogs) {
+	logs := &ObservedLogs{}
+	return fxeventzerolog.NewWithSink(logs, opts...), logs
+}
+
+// ObservedLogs is a concurrency-safe, ordered collection of records. It is
+// an fxeventzerolog.EventSink.
+type ObservedLogs struct {
+	mu      sync.RWMutex
+	records []fxeventzerolog.Record
+}
+
+var _ fxeventzerolog.EventSink = (*ObservedLogs)(nil)
+
+// Write adds r to the collection.
+func (o *ObservedLogs) Write(r fxeventzerolog.Record) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.records = append(o.records, r)
+}
+
+// Len returns the number of records in the collection.
+func (o *ObservedLogs) Len() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return len(o.records)
+}
+
+// All returns a copy of the records in the collection.
+func (o *ObservedLogs) All() []fxeventzerolog.Record {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return append([]fxeventzerolog.Record(nil), o.records...)
+}
+
+// TakeAll returns the records in the collection and empties it.
+func (o *ObservedLogs) TakeAll() []fxeventzerolog.Record {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	records := o.records
+	o.records = nil
+	return records
+}
+
+// FilterMessage returns the records with the message msg.
+func (o *ObservedLogs) FilterMessage(msg string) *ObservedLogs {
+	return o.filter(func(r fxeventzerolog.Record) bool {
+		return r.Message == msg
+	})
+}
+
+// FilterLevel returns the records at lvl or above.
+func (o *ObservedLogs) FilterLevel(lvl zerolog.Level) *ObservedLogs {
+	return o.filter(func(r fxeventzerolog.Record) bool {
+		return r.Level >= lvl
+	})
+}
+
+// FilterField returns the records with a top-level field key holding value,
+// compared with reflect.DeepEqual. Integer fields hold int64 values.
+func (o *ObservedLogs) FilterField(key string, value any) *ObservedLogs {
+	return o.filter(func(r fxeventzerolog.Record) bool {
+		for _, f := range r.Fields {
+			if f.Key == key && reflect.DeepEqual(f.Value, value) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// filter returns the records for which keep returns true.
+func (o *ObservedLogs) filter(keep func(fxeventzerolog.Record) bool) *ObservedLogs {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	var filtered []fxeventzerolog.Record
+	for _, r := range o.records {
+		if keep(r) {
+			filtered = append(filtered, r)
+		}
+	}
+	return &ObservedLogs{records: filtered}
+}
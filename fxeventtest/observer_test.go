@@ -0,0 +1,36 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"errors"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestNewObserved(t *testing.T) {
+	logger, logs := NewObserved(fxeventzerolog.WithoutStackTraces())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewDB()", OutputTypeNames: []string{"*main.DB"}, ModuleName: "db"})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+
+	if logs.Len() != 3 {
+		t.Fatalf("Expected 3 records, got %d: %+v", logs.Len(), logs.All())
+	}
+	if n := logs.FilterField("module", "db").Len(); n != 1 {
+		t.Errorf("Expected one record for module db, got %d", n)
+	}
+	if n := logs.FilterMessage("provided").Len(); n != 2 {
+		t.Errorf("Expected two provided records, got %d", n)
+	}
+	if n := logs.FilterLevel(zerolog.ErrorLevel).Len(); n != 1 {
+		t.Errorf("Expected one error record, got %d", n)
+	}
+	if all := logs.TakeAll(); len(all) != 3 || logs.Len() != 0 {
+		t.Errorf("Expected TakeAll to empty the collection, got %d left", logs.Len())
+	}
+}
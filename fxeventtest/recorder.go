@@ -0,0 +1,165 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxeventtest provides helpers for testing Fx applications and the
+// loggers that record their lifecycle.
+//
+// A Recorder stores the events of an application so tests can assert on them
+// directly rather than on captured log output:
+//
+//	rec := fxeventtest.NewRecorder()
+//	app := fxtest.New(t, fx.WithLogger(func() fxevent.Logger { return rec }), ...)
+//	app.RequireStart()
+//	rec.RequireStarted(t)
+package fxeventtest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// Recorder is an fxevent.Logger that stores every event it receives. It is
+// safe for concurrent use.
+type Recorder struct {
+	mu     sync.Mutex
+	events []fxevent.Event
+}
+
+var _ fxevent.Logger = (*Recorder)(nil)
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// LogEvent stores event.
+func (r *Recorder) LogEvent(event fxevent.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+// Events returns the events received so far, in order.
+func (r *Recorder) Events() []fxevent.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]fxevent.Event(nil), r.events...)
+}
+
+// Reset discards the events received so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = nil
+}
+
+// RequireStarted fails the test immediately unless the application has
+// started successfully.
+func (r *Recorder) RequireStarted(t testing.TB) {
+	t.Helper()
+	for _, event := range r.Events() {
+		if e, ok := event.(*fxevent.Started); ok {
+			if e.Err != nil {
+				t.Fatalf("application failed to start: %v", e.Err)
+			}
+			return
+		}
+	}
+	t.Fatal("application has not started")
+}
+
+// RequireStopped fails the test immediately unless the application has
+// stopped without error.
+func (r *Recorder) RequireStopped(t testing.TB) {
+	t.Helper()
+	for _, event := range r.Events() {
+		if e, ok := event.(*fxevent.Stopped); ok {
+			if e.Err != nil {
+				t.Fatalf("application failed to stop: %v", e.Err)
+			}
+			return
+		}
+	}
+	t.Fatal("application has not stopped")
+}
+
+// RequireNoErrors fails the test immediately if any event carries an error.
+func (r *Recorder) RequireNoErrors(t testing.TB) {
+	t.Helper()
+	for _, event := range r.Events() {
+		if err := eventError(event); err != nil {
+			t.Fatalf("%s failed: %v", reflect.TypeOf(event).Elem().Name(), err)
+		}
+	}
+}
+
+// HookDurations returns the total runtime of the executed OnStart and OnStop
+// hooks by function name.
+func (r *Recorder) HookDurations() map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for _, event := range r.Events() {
+		switch e := event.(type) {
+		case *fxevent.OnStartExecuted:
+			durations[e.FunctionName] += e.Runtime
+		case *fxevent.OnStopExecuted:
+			durations[e.FunctionName] += e.Runtime
+		}
+	}
+	return durations
+}
+
+// ErrorsOf returns the errors carried by the received events of the same
+// type as event, e.g. ErrorsOf(&fxevent.OnStartExecuted{}).
+func (r *Recorder) ErrorsOf(event fxevent.Event) []error {
+	t := reflect.TypeOf(event)
+	var errs []error
+	for _, e := range r.Events() {
+		if reflect.TypeOf(e) != t {
+			continue
+		}
+		if err := eventError(e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// eventError returns the error carried by event, if any.
+func eventError(event fxevent.Event) error {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		return e.Err
+	case *fxevent.OnStopExecuted:
+		return e.Err
+	case *fxevent.Supplied:
+		return e.Err
+	case *fxevent.Provided:
+		return e.Err
+	case *fxevent.Replaced:
+		return e.Err
+	case *fxevent.Decorated:
+		return e.Err
+	case *fxevent.Run:
+		return e.Err
+	case *fxevent.Invoked:
+		return e.Err
+	case *fxevent.Started:
+		return e.Err
+	case *fxevent.Stopped:
+		return e.Err
+	case *fxevent.RollingBack:
+		return e.StartErr
+	case *fxevent.RolledBack:
+		return e.Err
+	case *fxevent.LoggerInitialized:
+		return e.Err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+	"go.uber.org/fx/fxtest"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := NewRecorder()
+	app := fxtest.New(t,
+		fx.WithLogger(func() fxevent.Logger { return rec }),
+		fx.Invoke(func(lc fx.Lifecycle) {
+			lc.Append(fx.StartHook(func() {}))
+		}),
+	)
+	app.RequireStart()
+	rec.RequireStarted(t)
+	app.RequireStop()
+	rec.RequireStopped(t)
+	rec.RequireNoErrors(t)
+
+	if len(rec.HookDurations()) != 1 {
+		t.Errorf("Expected one hook duration, got %v", rec.HookDurations())
+	}
+}
+
+func TestRecorder_ErrorsOf(t *testing.T) {
+	rec := NewRecorder()
+	boom := errors.New("boom")
+	rec.LogEvent(&fxevent.OnStartExecuted{FunctionName: "a", Runtime: time.Second})
+	rec.LogEvent(&fxevent.OnStartExecuted{FunctionName: "b", Err: boom})
+	rec.LogEvent(&fxevent.OnStopExecuted{FunctionName: "a", Runtime: time.Second})
+	rec.LogEvent(&fxevent.Started{Err: boom})
+
+	if errs := rec.ErrorsOf(&fxevent.OnStartExecuted{}); len(errs) != 1 || errs[0] != boom {
+		t.Errorf("Expected the failed OnStart hook's error, got %v", errs)
+	}
+	if errs := rec.ErrorsOf(&fxevent.Invoked{}); len(errs) != 0 {
+		t.Errorf("Expected no Invoked errors, got %v", errs)
+	}
+	if d := rec.HookDurations()["a"]; d != 2*time.Second {
+		t.Errorf("Expected a's OnStart and OnStop runtimes summed, got %v", d)
+	}
+	rec.Reset()
+	if len(rec.Events()) != 0 {
+		t.Errorf("Expected no events after Reset, got %v", rec.Events())
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// Strict marks the test as failed with t.Errorf whenever a record is logged
+// for an event carrying an error, such as a failed hook, constructor or
+// invoke, so failures the application tolerates still fail the test. It is
+// intended for use with NewTestLogger:
+//
+//	logger := fxeventtest.NewTestLogger(t, fxeventtest.Strict(t))
+func Strict(t testing.TB) fxeventzerolog.Option {
+	return strict(t.Errorf)
+}
+
+// StrictFatal is like Strict, but stops the test with t.Fatalf. Like
+// t.Fatalf, it must only be used when events are logged from the test's own
+// goroutine, as they are by fxtest's RequireStart and RequireStop.
+func StrictFatal(t testing.TB) fxeventzerolog.Option {
+	return strict(t.Fatalf)
+}
+
+// strict reports each logged event carrying an error with fail, once.
+func strict(fail func(format string, args ...any)) fxeventzerolog.Option {
+	var (
+		mu   sync.Mutex
+		last fxevent.Event
+	)
+	return fxeventzerolog.WithOnLogged(func(event fxevent.Event, _ zerolog.Level) {
+		err := eventError(event)
+		if err == nil {
+			return
+		}
+		mu.Lock()
+		seen := event == last
+		last = event
+		mu.Unlock()
+		if seen {
+			return
+		}
+		fail("fx: %s failed: %v", reflect.TypeOf(event).Elem().Name(), err)
+	})
+}
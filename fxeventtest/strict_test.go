@@ -0,0 +1,66 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// errorRecorder is a testing.TB that keeps the failures reported through it.
+type errorRecorder struct {
+	logRecorder
+	errors []string
+}
+
+func (r *errorRecorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestStrict(t *testing.T) {
+	rec := &errorRecorder{logRecorder: logRecorder{TB: t}}
+	logger := NewTestLogger(rec, Strict(rec))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run"})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "main.stop", CallerName: "main.run", Err: errors.New("boom")})
+
+	if len(rec.errors) != 1 || rec.errors[0] != "fx: OnStopExecuted failed: boom" {
+		t.Errorf("Expected one failure for the failed hook, got %q", rec.errors)
+	}
+	if len(rec.lines) != 2 {
+		t.Errorf("Expected the records to still be logged, got %q", rec.lines)
+	}
+}
+
+func TestStrict_Concurrent(t *testing.T) {
+	var mu sync.Mutex
+	var failures int
+	zl := zerolog.New(io.Discard)
+	logger := fxeventzerolog.New(&zl, strict(func(string, ...any) {
+		mu.Lock()
+		failures++
+		mu.Unlock()
+	}))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom")})
+			}
+		}()
+	}
+	wg.Wait()
+	if failures != 800 {
+		t.Errorf("Expected every failed event to be reported, got %d", failures)
+	}
+}
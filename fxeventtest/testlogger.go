@@ -0,0 +1,31 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+)
+
+// NewTestLogger returns a Logger configured by opts that writes
+// human-readable records through t.Log, so the lifecycle of an application
+// under test is shown with the test's own output, and only when it fails or
+// runs verbosely:
+//
+//	app := fxtest.New(t,
+//		fx.WithLogger(func() fxevent.Logger { return fxeventtest.NewTestLogger(t) }),
+//		...
+//	)
+//
+// Records must not be logged after the test has completed.
+func NewTestLogger(t testing.TB, opts ...fxeventzerolog.Option) *fxeventzerolog.Logger {
+	logger := zerolog.New(zerolog.ConsoleWriter{
+		Out:        zerolog.NewTestWriter(t),
+		NoColor:    true,
+		PartsOrder: []string{zerolog.LevelFieldName, zerolog.CallerFieldName, zerolog.MessageFieldName},
+	})
+	return fxeventzerolog.New(&logger, opts...).(*fxeventzerolog.Logger)
+}
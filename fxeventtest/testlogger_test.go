@@ -0,0 +1,45 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// logRecorder is a testing.TB that keeps what is logged through it.
+type logRecorder struct {
+	testing.TB
+	lines []string
+}
+
+func (r *logRecorder) Helper() {}
+
+func (r *logRecorder) Log(args ...any) {
+	r.lines = append(r.lines, fmt.Sprint(args...))
+}
+
+func TestNewTestLogger(t *testing.T) {
+	rec := &logRecorder{TB: t}
+	logger := NewTestLogger(rec)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run"})
+	logger.LogEvent(&fxevent.Started{})
+
+	if len(rec.lines) != 3 {
+		t.Fatalf("Expected one t.Log call per record, got %q", rec.lines)
+	}
+	if !strings.Contains(rec.lines[0], "INF invoking") || !strings.Contains(rec.lines[0], "function=main.run()") {
+		t.Errorf("Expected human-readable output, got %q", rec.lines[0])
+	}
+	if !strings.Contains(rec.lines[1], "main.run >") {
+		t.Errorf("Expected the hook's caller, got %q", rec.lines[1])
+	}
+	if strings.HasSuffix(rec.lines[2], "\n") || !strings.Contains(rec.lines[2], "started") {
+		t.Errorf("Expected the started record without a trailing newline, got %q", rec.lines[2])
+	}
+}
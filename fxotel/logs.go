@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"context"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// WithLogs returns an option that also emits every record of an
+// fxeventzerolog.Logger through the OpenTelemetry log bridge, using a logger
+// from provider, with the same message, severity and attributes as the
+// zerolog output. The bridge's own level, as reported by its Enabled method,
+// decides what it receives, independently of the zerolog logger's level.
+// This gives lifecycle visibility in environments that ship logs via OTLP
+// rather than stdout:
+//
+//	fxeventzerolog.New(logger, fxotel.WithLogs(global.GetLoggerProvider()))
+func WithLogs(provider otellog.LoggerProvider) fxeventzerolog.Option {
+	return fxeventzerolog.WithTeeSink(logSink{logger: provider.Logger(scope)})
+}
+
+// logSink is a LeveledSink that emits records through an OpenTelemetry
+// logger.
+type logSink struct {
+	logger otellog.Logger
+}
+
+var _ fxeventzerolog.LeveledSink = logSink{}
+
+// Enabled reports whether the OpenTelemetry logger emits records at lvl.
+func (s logSink) Enabled(lvl zerolog.Level) bool {
+	return s.logger.Enabled(context.Background(), otellog.EnabledParameters{Severity: severity(lvl)})
+}
+
+// Write emits r through the OpenTelemetry logger.
+func (s logSink) Write(r fxeventzerolog.Record) {
+	var rec otellog.Record
+	rec.SetTimestamp(r.Time)
+	rec.SetSeverity(severity(r.Level))
+	rec.SetSeverityText(r.Level.String())
+	rec.SetBody(otellog.StringValue(r.Message))
+	rec.AddAttributes(logAttrs(r.Fields)...)
+	s.logger.Emit(context.Background(), rec)
+}
+
+// severity maps a zerolog level to an OpenTelemetry severity.
+func severity(lvl zerolog.Level) otellog.Severity {
+	switch lvl {
+	case zerolog.TraceLevel:
+		return otellog.SeverityTrace
+	case zerolog.DebugLevel:
+		return otellog.SeverityDebug
+	case zerolog.InfoLevel:
+		return otellog.SeverityInfo
+	case zerolog.WarnLevel:
+		return otellog.SeverityWarn
+	case zerolog.ErrorLevel:
+		return otellog.SeverityError
+	case zerolog.FatalLevel:
+		return otellog.SeverityFatal
+	case zerolog.PanicLevel:
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityUndefined
+	}
+}
+
+// logAttrs converts record fields to OpenTelemetry log attributes. Durations
+// are rendered as strings, as in the zerolog output.
+func logAttrs(fields []fxeventzerolog.Field) []otellog.KeyValue {
+	kvs := make([]otellog.KeyValue, 0, len(fields))
+	for _, f := range fields {
+		kvs = append(kvs, otellog.KeyValue{Key: f.Key, Value: logValue(f.Value)})
+	}
+	return kvs
+}
+
+// logValue converts the value of a record field.
+func logValue(v any) otellog.Value {
+	switch v := v.(type) {
+	case string:
+		return otellog.StringValue(v)
+	case []string:
+		vals := make([]otellog.Value, len(v))
+		for i, s := range v {
+			vals[i] = otellog.StringValue(s)
+		}
+		return otellog.SliceValue(vals...)
+	case bool:
+		return otellog.BoolValue(v)
+	case int64:
+		return otellog.Int64Value(v)
+	case float64:
+		return otellog.Float64Value(v)
+	case time.Duration:
+		return otellog.StringValue(v.String())
+	case error:
+		return otellog.StringValue(v.Error())
+	case []fxeventzerolog.Field:
+		return otellog.MapValue(logAttrs(v)...)
+	case [][]fxeventzerolog.Field:
+		vals := make([]otellog.Value, len(v))
+		for i, obj := range v {
+			vals[i] = otellog.MapValue(logAttrs(obj)...)
+		}
+		return otellog.SliceValue(vals...)
+	default:
+		return otellog.Value{}
+	}
+}
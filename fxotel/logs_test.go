@@ -0,0 +1,112 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/amari/fxevent-zerolog/fxeventtest"
+	"github.com/rs/zerolog"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+	"go.uber.org/fx/fxevent"
+)
+
+// fakeOTelLogger records emitted OpenTelemetry log records.
+type fakeOTelLogger struct {
+	embedded.Logger
+	min     otellog.Severity // lowest severity enabled
+	records []otellog.Record
+}
+
+func (f *fakeOTelLogger) Emit(_ context.Context, r otellog.Record) {
+	f.records = append(f.records, r)
+}
+
+func (f *fakeOTelLogger) Enabled(_ context.Context, param otellog.EnabledParameters) bool {
+	return param.Severity >= f.min
+}
+
+type fakeOTelProvider struct {
+	embedded.LoggerProvider
+	logger *fakeOTelLogger
+}
+
+func (p *fakeOTelProvider) Logger(string, ...otellog.LoggerOption) otellog.Logger {
+	return p.logger
+}
+
+// otelAttr returns the value of the attribute key in r.
+func otelAttr(r otellog.Record, key string) (otellog.Value, bool) {
+	var val otellog.Value
+	var found bool
+	r.WalkAttributes(func(kv otellog.KeyValue) bool {
+		if kv.Key == key {
+			val, found = kv.Value, true
+			return false
+		}
+		return true
+	})
+	return val, found
+}
+
+func TestWithLogs(t *testing.T) {
+	provider := &fakeOTelProvider{logger: &fakeOTelLogger{}}
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	logger := fxeventzerolog.New(&zl, WithLogs(provider), fxeventzerolog.WithClock(fxeventtest.NewFakeClock(now)))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+
+	if buf.Len() == 0 {
+		t.Error("Expected records to still be written to zerolog")
+	}
+	records := provider.logger.records
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 OpenTelemetry records, got %d", len(records))
+	}
+	if records[0].Body().AsString() != "OnStart hook executed" || records[0].Severity() != otellog.SeverityInfo {
+		t.Errorf("Expected info OnStart record, got %q at %v", records[0].Body().AsString(), records[0].Severity())
+	}
+	if !records[0].Timestamp().Equal(now) {
+		t.Errorf("Expected the Logger's clock to set the timestamp, got %v", records[0].Timestamp())
+	}
+	if v, ok := otelAttr(records[0], "runtime"); !ok || v.AsString() != "1ms" {
+		t.Errorf("Expected runtime attribute, got %v", v)
+	}
+	if v, ok := otelAttr(records[0], "callee"); !ok || v.AsString() != "f" {
+		t.Errorf("Expected callee attribute, got %v", v)
+	}
+	if records[1].Severity() != otellog.SeverityError {
+		t.Errorf("Expected error severity, got %v", records[1].Severity())
+	}
+	if v, ok := otelAttr(records[1], zerolog.ErrorFieldName); !ok || v.AsString() != "boom" {
+		t.Errorf("Expected error attribute, got %v", v)
+	}
+}
+
+func TestWithLogs_Levels(t *testing.T) {
+	provider := &fakeOTelProvider{logger: &fakeOTelLogger{}}
+	zbuf := &bytes.Buffer{}
+	zl := zerolog.New(zbuf).Level(zerolog.ErrorLevel)
+	logger := fxeventzerolog.New(&zl, WithLogs(provider))
+	logger.LogEvent(&fxevent.Started{})
+	if len(provider.logger.records) != 1 || zbuf.Len() > 0 {
+		t.Errorf("Expected the bridge's own level to decide, got %d records and %s", len(provider.logger.records), zbuf.String())
+	}
+
+	provider = &fakeOTelProvider{logger: &fakeOTelLogger{min: otellog.SeverityError}}
+	logger = fxeventzerolog.New(&zl, WithLogs(provider))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	if len(provider.logger.records) != 1 || provider.logger.records[0].Severity() != otellog.SeverityError {
+		t.Errorf("Expected records below the bridge's level to be skipped, got %d", len(provider.logger.records))
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxotel records OpenTelemetry telemetry from Fx lifecycle events.
+//
+// Each integration decorates an fxevent.Logger, such as one created by
+// fxeventzerolog.New, so that every event is both logged and recorded:
+//
+//	fx.WithLogger(func(logger *zerolog.Logger) (fxevent.Logger, error) {
+//		return fxotel.WrapMetrics(fxeventzerolog.New(logger), otel.GetMeterProvider())
+//	})
+package fxotel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx/fxevent"
+)
+
+// scope is the instrumentation scope name used for meters and tracers.
+const scope = "github.com/amari/fxevent-zerolog/fxotel"
+
+// MetricsLogger is an fxevent.Logger that records OpenTelemetry metrics for
+// each event before forwarding it to the wrapped logger.
+type MetricsLogger struct {
+	next fxevent.Logger
+
+	hookDuration  metric.Float64Histogram
+	constructors  metric.Int64Counter
+	startFailures metric.Int64Counter
+	stopFailures  metric.Int64Counter
+}
+
+var _ fxevent.Logger = (*MetricsLogger)(nil)
+
+// WrapMetrics returns a MetricsLogger that records metrics with a meter from
+// mp and forwards events to next. next may be nil, in which case events are
+// only recorded. The following instruments are created:
+//
+//   - fx.hook.duration: histogram of hook runtimes in seconds, with the hook
+//     function name and the phase ("start" or "stop") as attributes
+//   - fx.constructors: counter of provided constructors, by module
+//   - fx.start.failures: counter of failed application starts
+//   - fx.stop.failures: counter of failed application stops
+//
+// It returns an error if an instrument cannot be created.
+func WrapMetrics(next fxevent.Logger, mp metric.MeterProvider) (*MetricsLogger, error) {
+	meter := mp.Meter(scope)
+	l := &MetricsLogger{next: next}
+
+	var err error
+	if l.hookDuration, err = meter.Float64Histogram("fx.hook.duration",
+		metric.WithDescription("Runtime of Fx lifecycle hooks."),
+		metric.WithUnit("s")); err != nil {
+		return nil, err
+	}
+	if l.constructors, err = meter.Int64Counter("fx.constructors",
+		metric.WithDescription("Number of constructors provided to the Fx container."),
+		metric.WithUnit("{constructor}")); err != nil {
+		return nil, err
+	}
+	if l.startFailures, err = meter.Int64Counter("fx.start.failures",
+		metric.WithDescription("Number of failed Fx application starts."),
+		metric.WithUnit("{failure}")); err != nil {
+		return nil, err
+	}
+	if l.stopFailures, err = meter.Int64Counter("fx.stop.failures",
+		metric.WithDescription("Number of failed Fx application stops."),
+		metric.WithUnit("{failure}")); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// LogEvent records metrics for event and forwards it to the wrapped logger.
+func (l *MetricsLogger) LogEvent(event fxevent.Event) {
+	ctx := context.Background()
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		if e.Err == nil {
+			l.constructors.Add(ctx, 1, metric.WithAttributes(attribute.String("module", e.ModuleName)))
+		}
+	case *fxevent.OnStartExecuted:
+		l.hookDuration.Record(ctx, e.Runtime.Seconds(), metric.WithAttributes(
+			attribute.String("hook", e.FunctionName), attribute.String("phase", "start")))
+	case *fxevent.OnStopExecuted:
+		l.hookDuration.Record(ctx, e.Runtime.Seconds(), metric.WithAttributes(
+			attribute.String("hook", e.FunctionName), attribute.String("phase", "stop")))
+	case *fxevent.Started:
+		if e.Err != nil {
+			l.startFailures.Add(ctx, 1)
+		}
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			l.stopFailures.Add(ctx, 1)
+		}
+	}
+
+	if l.next != nil {
+		l.next.LogEvent(event)
+	}
+}
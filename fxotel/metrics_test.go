@@ -0,0 +1,89 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.uber.org/fx/fxevent"
+)
+
+type recorder struct {
+	events []fxevent.Event
+}
+
+func (r *recorder) LogEvent(e fxevent.Event) {
+	r.events = append(r.events, e)
+}
+
+// collect returns the metrics gathered by reader, by name.
+func collect(t *testing.T, reader sdkmetric.Reader) map[string]metricdata.Metrics {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	out := make(map[string]metricdata.Metrics)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			out[m.Name] = m
+		}
+	}
+	return out
+}
+
+func TestWrapMetrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	next := &recorder{}
+	l, err := WrapMetrics(next, mp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "a()", ModuleName: "m"},
+		&fxevent.Provided{ConstructorName: "b()", ModuleName: "m"},
+		&fxevent.Provided{ConstructorName: "c()", Err: errors.New("bad")},
+		&fxevent.OnStartExecuted{FunctionName: "a", Runtime: 2 * time.Second},
+		&fxevent.OnStopExecuted{FunctionName: "a", Runtime: time.Second},
+		&fxevent.Started{Err: errors.New("fail")},
+		&fxevent.Stopped{},
+	}
+	for _, e := range events {
+		l.LogEvent(e)
+	}
+	if len(next.events) != len(events) {
+		t.Errorf("Expected all events to be forwarded, got %d", len(next.events))
+	}
+
+	metrics := collect(t, reader)
+	ctors, ok := metrics["fx.constructors"].Data.(metricdata.Sum[int64])
+	if !ok || len(ctors.DataPoints) != 1 || ctors.DataPoints[0].Value != 2 {
+		t.Errorf("Expected 2 constructors in one module, got %+v", metrics["fx.constructors"].Data)
+	}
+	hooks, ok := metrics["fx.hook.duration"].Data.(metricdata.Histogram[float64])
+	if !ok || len(hooks.DataPoints) != 2 {
+		t.Fatalf("Expected hook durations by phase, got %+v", metrics["fx.hook.duration"].Data)
+	}
+	var total float64
+	for _, dp := range hooks.DataPoints {
+		total += dp.Sum
+	}
+	if total != 3 {
+		t.Errorf("Expected 3s of hook time, got %v", total)
+	}
+	failures, ok := metrics["fx.start.failures"].Data.(metricdata.Sum[int64])
+	if !ok || len(failures.DataPoints) != 1 || failures.DataPoints[0].Value != 1 {
+		t.Errorf("Expected 1 start failure, got %+v", metrics["fx.start.failures"].Data)
+	}
+	if _, ok := metrics["fx.stop.failures"]; ok {
+		t.Error("Expected no stop failures to be recorded")
+	}
+}
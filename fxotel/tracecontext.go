@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"context"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// WithTraceContext returns an option that stamps trace_id and span_id fields
+// from the OpenTelemetry span in ctx on every record of an
+// fxeventzerolog.Logger, as fxeventzerolog.WithTraceContextFunc does. No
+// fields are added if ctx holds no valid span.
+func WithTraceContext(ctx context.Context) fxeventzerolog.Option {
+	return fxeventzerolog.WithTraceContextFunc(func() (string, string) {
+		sc := trace.SpanContextFromContext(ctx)
+		if !sc.IsValid() {
+			return "", ""
+		}
+		return sc.TraceID().String(), sc.SpanID().String()
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	fxeventzerolog "github.com/amari/fxevent-zerolog"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithTraceContext(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	logger := fxeventzerolog.New(&zl, WithTraceContext(ctx))
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, `"trace_id":"01000000000000000000000000000000","span_id":"0200000000000000"`) {
+		t.Errorf("Expected trace_id and span_id, got %s", out)
+	}
+}
+
+func TestWithTraceContext_NoSpan(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	logger := fxeventzerolog.New(&zl, WithTraceContext(context.Background()))
+	logger.LogEvent(&fxevent.Started{})
+	if strings.Contains(buf.String(), "trace_id") {
+		t.Errorf("Expected no trace fields without a span, got %s", buf.String())
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx/fxevent"
+)
+
+// TracingLogger is an fxevent.Logger that records OpenTelemetry spans for
+// lifecycle hooks before forwarding each event to the wrapped logger.
+type TracingLogger struct {
+	next   fxevent.Logger
+	tracer trace.Tracer
+
+	mu       sync.Mutex
+	start    trace.Span            // fx.start, from the first OnStart hook to Started
+	rollback trace.Span            // fx.rollback, from RollingBack to RolledBack
+	stop     trace.Span            // fx.stop, from Stopping to Stopped
+	hooks    map[string]trace.Span // running hooks, by phase, caller and function
+}
+
+var _ fxevent.Logger = (*TracingLogger)(nil)
+
+// WrapTracing returns a TracingLogger that creates spans with a tracer from
+// tp and forwards events to next. next may be nil, in which case events are
+// only traced.
+//
+// Each OnStart and OnStop hook gets a span from its Executing event to its
+// Executed event. Hooks are nested under a parent span for the whole startup
+// (fx.start), shutdown (fx.stop) or rollback (fx.rollback, itself within
+// fx.start). Failed hooks and phases record the error and set an error status.
+func WrapTracing(next fxevent.Logger, tp trace.TracerProvider) *TracingLogger {
+	return &TracingLogger{
+		next:   next,
+		tracer: tp.Tracer(scope),
+		hooks:  make(map[string]trace.Span),
+	}
+}
+
+// LogEvent records spans for event and forwards it to the wrapped logger.
+func (l *TracingLogger) LogEvent(event fxevent.Event) {
+	l.mu.Lock()
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuting:
+		if l.start == nil {
+			_, l.start = l.tracer.Start(context.Background(), "fx.start")
+		}
+		l.startHook("start", l.start, e.FunctionName, e.CallerName)
+	case *fxevent.OnStartExecuted:
+		l.endHook("start", e.FunctionName, e.CallerName, e.Err)
+	case *fxevent.OnStopExecuting:
+		parent := l.rollback
+		if parent == nil {
+			if l.stop == nil {
+				_, l.stop = l.tracer.Start(context.Background(), "fx.stop")
+			}
+			parent = l.stop
+		}
+		l.startHook("stop", parent, e.FunctionName, e.CallerName)
+	case *fxevent.OnStopExecuted:
+		l.endHook("stop", e.FunctionName, e.CallerName, e.Err)
+	case *fxevent.RollingBack:
+		ctx := context.Background()
+		if l.start != nil {
+			ctx = trace.ContextWithSpan(ctx, l.start)
+		}
+		_, l.rollback = l.tracer.Start(ctx, "fx.rollback")
+		l.rollback.RecordError(e.StartErr)
+	case *fxevent.RolledBack:
+		endSpan(l.rollback, e.Err)
+		l.rollback = nil
+	case *fxevent.Started:
+		endSpan(l.start, e.Err)
+		l.start = nil
+	case *fxevent.Stopping:
+		if l.stop == nil {
+			_, l.stop = l.tracer.Start(context.Background(), "fx.stop")
+		}
+		l.stop.SetAttributes(attribute.String("fx.signal", e.Signal.String()))
+	case *fxevent.Stopped:
+		endSpan(l.stop, e.Err)
+		l.stop = nil
+	}
+	l.mu.Unlock()
+
+	if l.next != nil {
+		l.next.LogEvent(event)
+	}
+}
+
+// startHook opens the span of a hook within parent.
+func (l *TracingLogger) startHook(phase string, parent trace.Span, function, caller string) {
+	ctx := trace.ContextWithSpan(context.Background(), parent)
+	_, span := l.tracer.Start(ctx, "fx.on"+phase+" "+function, trace.WithAttributes(
+		attribute.String("fx.hook.function", function),
+		attribute.String("fx.hook.caller", caller),
+		attribute.String("fx.hook.phase", phase),
+	))
+	l.hooks[phase+"|"+caller+"|"+function] = span
+}
+
+// endHook closes the span of a hook, if it is open.
+func (l *TracingLogger) endHook(phase, function, caller string, err error) {
+	key := phase + "|" + caller + "|" + function
+	if span, ok := l.hooks[key]; ok {
+		endSpan(span, err)
+		delete(l.hooks, key)
+	}
+}
+
+// endSpan records err, if any, and ends span. It is a no-op for a nil span.
+func endSpan(span trace.Span, err error) {
+	if span == nil {
+		return
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxotel
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/fx/fxevent"
+)
+
+// spans returns the ended spans by name.
+func spans(sr *tracetest.SpanRecorder) map[string]sdktrace.ReadOnlySpan {
+	out := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range sr.Ended() {
+		out[s.Name()] = s
+	}
+	return out
+}
+
+func TestWrapTracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	next := &recorder{}
+	l := WrapTracing(next, tp)
+
+	events := []fxevent.Event{
+		&fxevent.OnStartExecuting{FunctionName: "a", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "a", CallerName: "c"},
+		&fxevent.Started{},
+		&fxevent.Stopping{Signal: os.Interrupt},
+		&fxevent.OnStopExecuting{FunctionName: "b", CallerName: "c"},
+		&fxevent.OnStopExecuted{FunctionName: "b", CallerName: "c", Err: errors.New("fail")},
+		&fxevent.Stopped{Err: errors.New("fail")},
+	}
+	for _, e := range events {
+		l.LogEvent(e)
+	}
+	if len(next.events) != len(events) {
+		t.Errorf("Expected all events to be forwarded, got %d", len(next.events))
+	}
+
+	got := spans(sr)
+	start, hook := got["fx.start"], got["fx.onstart a"]
+	if start == nil || hook == nil {
+		t.Fatalf("Expected start and hook spans, got %v", got)
+	}
+	if hook.Parent().SpanID() != start.SpanContext().SpanID() {
+		t.Error("Expected hook span to be a child of fx.start")
+	}
+	stop, stopHook := got["fx.stop"], got["fx.onstop b"]
+	if stop == nil || stopHook == nil {
+		t.Fatalf("Expected stop and hook spans, got %v", got)
+	}
+	if stopHook.Parent().SpanID() != stop.SpanContext().SpanID() {
+		t.Error("Expected stop hook span to be a child of fx.stop")
+	}
+	if stopHook.Status().Code != codes.Error || stop.Status().Code != codes.Error {
+		t.Error("Expected failed stop to set an error status")
+	}
+	if start.Status().Code == codes.Error {
+		t.Error("Expected successful start to not set an error status")
+	}
+}
+
+func TestWrapTracing_Rollback(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	l := WrapTracing(nil, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+
+	err := errors.New("boom")
+	for _, e := range []fxevent.Event{
+		&fxevent.OnStartExecuting{FunctionName: "a", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "a", CallerName: "c"},
+		&fxevent.OnStartExecuting{FunctionName: "b", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "b", CallerName: "c", Err: err},
+		&fxevent.RollingBack{StartErr: err},
+		&fxevent.OnStopExecuting{FunctionName: "a", CallerName: "c"},
+		&fxevent.OnStopExecuted{FunctionName: "a", CallerName: "c"},
+		&fxevent.RolledBack{},
+		&fxevent.Started{Err: err},
+	} {
+		l.LogEvent(e)
+	}
+
+	got := spans(sr)
+	start, rollback, undo := got["fx.start"], got["fx.rollback"], got["fx.onstop a"]
+	if start == nil || rollback == nil || undo == nil {
+		t.Fatalf("Expected start, rollback and undo spans, got %v", got)
+	}
+	if rollback.Parent().SpanID() != start.SpanContext().SpanID() {
+		t.Error("Expected fx.rollback to be a child of fx.start")
+	}
+	if undo.Parent().SpanID() != rollback.SpanContext().SpanID() {
+		t.Error("Expected rollback hook span to be a child of fx.rollback")
+	}
+	if _, ok := got["fx.stop"]; ok {
+		t.Error("Expected no fx.stop span during rollback")
+	}
+	if start.Status().Code != codes.Error {
+		t.Error("Expected failed start to set an error status")
+	}
+}
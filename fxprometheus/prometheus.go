@@ -0,0 +1,154 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxprometheus records Prometheus metrics from Fx lifecycle events.
+//
+// Wrap decorates an fxevent.Logger, such as one created by fxeventzerolog.New,
+// so that every event is both logged and recorded:
+//
+//	fx.WithLogger(func(logger *zerolog.Logger) (fxevent.Logger, error) {
+//		return fxprometheus.Wrap(fxeventzerolog.New(logger), prometheus.DefaultRegisterer)
+//	})
+package fxprometheus
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx/fxevent"
+)
+
+// Lifecycle phases reported by the fx_lifecycle_phase gauge.
+var phases = []string{"initializing", "starting", "started", "stopping", "stopped", "rolling_back", "rolled_back"}
+
+// Logger is an fxevent.Logger that records Prometheus metrics for each event
+// before forwarding it to the wrapped logger.
+type Logger struct {
+	next fxevent.Logger
+
+	hookDuration  *prometheus.HistogramVec // by hook and phase
+	startFailures prometheus.Counter
+	stopFailures  prometheus.Counter
+	phase         *prometheus.GaugeVec // by phase, 1 for the current phase
+
+	mu      sync.Mutex
+	current string // current lifecycle phase
+}
+
+var _ fxevent.Logger = (*Logger)(nil)
+
+// Wrap returns a Logger that records metrics to reg and forwards events to
+// next. next may be nil, in which case events are only recorded. The
+// following metrics are registered:
+//
+//   - fx_hook_duration_seconds: histogram of hook runtimes, labeled by hook
+//     (the hook function name) and phase ("start" or "stop")
+//   - fx_start_failures_total: counter of failed application starts
+//   - fx_stop_failures_total: counter of failed application stops
+//   - fx_lifecycle_phase: gauge set to 1 for the current phase label and 0
+//     for the others
+//
+// It returns an error if the metrics cannot be registered.
+func Wrap(next fxevent.Logger, reg prometheus.Registerer) (*Logger, error) {
+	l := &Logger{
+		next: next,
+		hookDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "fx_hook_duration_seconds",
+			Help:    "Runtime of Fx lifecycle hooks.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"hook", "phase"}),
+		startFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "fx_start_failures_total",
+			Help: "Number of failed Fx application starts.",
+		}),
+		stopFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "fx_stop_failures_total",
+			Help: "Number of failed Fx application stops.",
+		}),
+		phase: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "fx_lifecycle_phase",
+			Help: "Current Fx lifecycle phase; 1 for the current phase, 0 otherwise.",
+		}, []string{"phase"}),
+	}
+	collectors := []prometheus.Collector{l.hookDuration, l.startFailures, l.stopFailures, l.phase}
+	for i, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			// Unregister the metrics registered so far, so that a
+			// failed Wrap leaves reg as it found it.
+			for _, registered := range collectors[:i] {
+				reg.Unregister(registered)
+			}
+			return nil, err
+		}
+	}
+	l.setPhase("initializing")
+	return l, nil
+}
+
+// LogEvent records metrics for event and forwards it to the wrapped logger.
+func (l *Logger) LogEvent(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuting:
+		l.setPhaseFrom("initializing", "starting")
+	case *fxevent.OnStartExecuted:
+		l.hookDuration.WithLabelValues(e.FunctionName, "start").Observe(e.Runtime.Seconds())
+	case *fxevent.OnStopExecuting:
+		l.setPhaseFrom("started", "stopping")
+	case *fxevent.OnStopExecuted:
+		l.hookDuration.WithLabelValues(e.FunctionName, "stop").Observe(e.Runtime.Seconds())
+	case *fxevent.Started:
+		if e.Err != nil {
+			l.startFailures.Inc()
+		} else {
+			l.setPhase("started")
+		}
+	case *fxevent.Stopping:
+		l.setPhase("stopping")
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			l.stopFailures.Inc()
+		}
+		l.setPhase("stopped")
+	case *fxevent.RollingBack:
+		l.setPhase("rolling_back")
+	case *fxevent.RolledBack:
+		l.setPhase("rolled_back")
+	}
+
+	if l.next != nil {
+		l.next.LogEvent(event)
+	}
+}
+
+// setPhase makes phase the current lifecycle phase.
+func (l *Logger) setPhase(phase string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updatePhase(phase)
+}
+
+// setPhaseFrom moves to phase only if the current phase is from. The check
+// and the move happen under the same lock, so that a concurrent setPhase
+// cannot be overwritten.
+func (l *Logger) setPhaseFrom(from, phase string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.current == from {
+		l.updatePhase(phase)
+	}
+}
+
+// updatePhase makes phase the current lifecycle phase. It must be called with
+// l.mu held.
+func (l *Logger) updatePhase(phase string) {
+	l.current = phase
+	for _, p := range phases {
+		v := 0.0
+		if p == phase {
+			v = 1
+		}
+		l.phase.WithLabelValues(p).Set(v)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxprometheus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/fx/fxevent"
+)
+
+type recorder struct {
+	events []fxevent.Event
+}
+
+func (r *recorder) LogEvent(e fxevent.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestWrap(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	next := &recorder{}
+	l, err := Wrap(next, reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := testutil.ToFloat64(l.phase.WithLabelValues("initializing")); got != 1 {
+		t.Errorf("Expected initializing phase, got %v", got)
+	}
+
+	events := []fxevent.Event{
+		&fxevent.OnStartExecuting{FunctionName: "a", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "a", CallerName: "c", Runtime: 2 * time.Second},
+		&fxevent.Started{},
+	}
+	for _, e := range events {
+		l.LogEvent(e)
+	}
+	if got := testutil.ToFloat64(l.phase.WithLabelValues("started")); got != 1 {
+		t.Errorf("Expected started phase, got %v", got)
+	}
+	if got := testutil.ToFloat64(l.phase.WithLabelValues("starting")); got != 0 {
+		t.Errorf("Expected starting phase to be cleared, got %v", got)
+	}
+
+	l.LogEvent(&fxevent.OnStopExecuting{FunctionName: "a", CallerName: "c"})
+	l.LogEvent(&fxevent.OnStopExecuted{FunctionName: "a", CallerName: "c", Runtime: time.Millisecond})
+	l.LogEvent(&fxevent.Stopped{Err: errors.New("fail")})
+	if got := testutil.ToFloat64(l.stopFailures); got != 1 {
+		t.Errorf("Expected 1 stop failure, got %v", got)
+	}
+	if got := testutil.ToFloat64(l.startFailures); got != 0 {
+		t.Errorf("Expected no start failures, got %v", got)
+	}
+	if got := testutil.CollectAndCount(l.hookDuration); got != 2 {
+		t.Errorf("Expected 2 hook duration series, got %d", got)
+	}
+	if len(next.events) != 6 {
+		t.Errorf("Expected all events to be forwarded, got %d", len(next.events))
+	}
+}
+
+func TestWrap_RegistrationError(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := Wrap(nil, reg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Wrap(nil, reg); err == nil {
+		t.Error("Expected duplicate registration to fail")
+	}
+}
+
+func TestWrap_RegistrationErrorUnregisters(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	conflict := prometheus.NewCounter(prometheus.CounterOpts{Name: "fx_stop_failures_total", Help: "Number of failed Fx application stops."})
+	reg.MustRegister(conflict)
+	if _, err := Wrap(nil, reg); err == nil {
+		t.Fatal("Expected a conflicting registration to fail")
+	}
+
+	reg.Unregister(conflict)
+	if _, err := Wrap(nil, reg); err != nil {
+		t.Errorf("Expected the metrics of a failed Wrap to have been unregistered, got %v", err)
+	}
+}
+
+func TestWrap_NilNext(t *testing.T) {
+	l, err := Wrap(nil, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.LogEvent(&fxevent.Started{Err: errors.New("fail")})
+	if got := testutil.ToFloat64(l.startFailures); got != 1 {
+		t.Errorf("Expected 1 start failure, got %v", got)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxsentry reports Fx lifecycle failures to Sentry.
+//
+// Wrap decorates an fxevent.Logger, such as one created by fxeventzerolog.New,
+// so that failures are both logged and captured:
+//
+//	fx.WithLogger(func(logger *zerolog.Logger) fxevent.Logger {
+//		return fxsentry.Wrap(fxeventzerolog.New(logger), sentry.CurrentHub())
+//	})
+package fxsentry
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/fx/fxevent"
+)
+
+// Logger is an fxevent.Logger that captures failed lifecycle events in
+// Sentry before forwarding each event to the wrapped logger.
+type Logger struct {
+	next fxevent.Logger
+	hub  *sentry.Hub
+
+	mu     sync.Mutex
+	owners map[string]string // function name to module name
+	hooks  []error           // hook failures captured since the last start or stop
+}
+
+var _ fxevent.Logger = (*Logger)(nil)
+
+// Wrap returns a Logger that captures failures with hub and forwards events
+// to next. next may be nil, in which case events are only captured.
+//
+// Failed OnStart and OnStop hooks, rollbacks and failed starts and stops are
+// captured as exceptions tagged with fx.phase ("start", "stop" or
+// "rollback") and, where known, fx.hook and fx.module. Hooks are attributed
+// to the module of the constructor or invoked function that registered them.
+// A rollback is not captured when its error is a hook failure already
+// captured, so one failing hook is reported once with its hook tags. Failed
+// starts are always captured, at the fatal level.
+func Wrap(next fxevent.Logger, hub *sentry.Hub) *Logger {
+	return &Logger{
+		next:   next,
+		hub:    hub,
+		owners: make(map[string]string),
+	}
+}
+
+// LogEvent captures event if it is a failure and forwards it to the wrapped
+// logger.
+func (l *Logger) LogEvent(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		l.own(e.ConstructorName, e.ModuleName)
+	case *fxevent.Decorated:
+		l.own(e.DecoratorName, e.ModuleName)
+	case *fxevent.Invoking:
+		l.own(e.FunctionName, e.ModuleName)
+	case *fxevent.OnStartExecuted:
+		if e.Err != nil {
+			l.capture(e.Err, sentry.LevelError, "start", e.FunctionName, l.owner(e.CallerName))
+			l.hookCaptured(e.Err)
+		}
+	case *fxevent.OnStopExecuted:
+		if e.Err != nil {
+			l.capture(e.Err, sentry.LevelError, "stop", e.FunctionName, l.owner(e.CallerName))
+			l.hookCaptured(e.Err)
+		}
+	case *fxevent.RollingBack:
+		if !l.hookFailed(e.StartErr) {
+			l.capture(e.StartErr, sentry.LevelError, "rollback", "", "")
+		}
+	case *fxevent.RolledBack:
+		if e.Err != nil && !l.hookFailed(e.Err) {
+			l.capture(e.Err, sentry.LevelError, "rollback", "", "")
+		}
+	case *fxevent.Started:
+		if e.Err != nil {
+			l.capture(e.Err, sentry.LevelFatal, "start", "", "")
+		}
+		l.resetHooks()
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			l.capture(e.Err, sentry.LevelError, "stop", "", "")
+		}
+		l.resetHooks()
+	}
+
+	if l.next != nil {
+		l.next.LogEvent(event)
+	}
+}
+
+// capture sends err to Sentry with the lifecycle tags.
+func (l *Logger) capture(err error, level sentry.Level, phase, hook, module string) {
+	if err == nil {
+		return
+	}
+	l.hub.WithScope(func(scope *sentry.Scope) {
+		scope.SetLevel(level)
+		scope.SetTag("fx.phase", phase)
+		if len(hook) > 0 {
+			scope.SetTag("fx.hook", hook)
+		}
+		if len(module) > 0 {
+			scope.SetTag("fx.module", module)
+		}
+		l.hub.CaptureException(err)
+	})
+}
+
+// own records the module of a constructor or invoked function.
+func (l *Logger) own(function, module string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.owners[strings.TrimSuffix(function, "()")] = module
+}
+
+// owner returns the module of the function that registered a hook.
+func (l *Logger) owner(caller string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.owners[strings.TrimSuffix(caller, "()")]
+}
+
+// hookCaptured records a captured hook failure.
+func (l *Logger) hookCaptured(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hooks = append(l.hooks, err)
+}
+
+// hookFailed reports whether err is, or wraps, a captured hook failure.
+func (l *Logger) hookFailed(err error) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return slices.ContainsFunc(l.hooks, func(hook error) bool {
+		return errors.Is(err, hook)
+	})
+}
+
+// resetHooks forgets the captured hook failures once a start or stop ends.
+func (l *Logger) resetHooks() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.hooks = nil
+}
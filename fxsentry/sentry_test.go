@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxsentry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/fx/fxevent"
+)
+
+type recorder struct {
+	events []fxevent.Event
+}
+
+func (r *recorder) LogEvent(e fxevent.Event) {
+	r.events = append(r.events, e)
+}
+
+// newHub returns a hub whose captured events are appended to events.
+func newHub(t *testing.T, events *[]*sentry.Event) *sentry.Hub {
+	t.Helper()
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		BeforeSend: func(event *sentry.Event, _ *sentry.EventHint) *sentry.Event {
+			*events = append(*events, event)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sentry.NewHub(client, sentry.NewScope())
+}
+
+func TestWrap(t *testing.T) {
+	var captured []*sentry.Event
+	next := &recorder{}
+	l := Wrap(next, newHub(t, &captured))
+
+	boom := errors.New("boom")
+	events := []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "main.NewServer()", ModuleName: "server"},
+		&fxevent.OnStartExecuted{FunctionName: "main.NewServer.func1()", CallerName: "main.NewServer", Err: boom},
+		&fxevent.OnStartExecuted{FunctionName: "main.ok()", CallerName: "main.NewServer"},
+		&fxevent.RollingBack{StartErr: boom},
+		&fxevent.RolledBack{},
+		&fxevent.Started{Err: boom},
+	}
+	for _, e := range events {
+		l.LogEvent(e)
+	}
+	if len(next.events) != len(events) {
+		t.Errorf("Expected all events to be forwarded, got %d", len(next.events))
+	}
+	if len(captured) != 2 {
+		t.Fatalf("Expected the hook failure and the failed start, got %d", len(captured))
+	}
+
+	hook := captured[0]
+	if hook.Tags["fx.phase"] != "start" || hook.Tags["fx.hook"] != "main.NewServer.func1()" || hook.Tags["fx.module"] != "server" {
+		t.Errorf("Expected hook failure tags, got %v", hook.Tags)
+	}
+	if captured[1].Level != sentry.LevelFatal {
+		t.Errorf("Expected failed start at fatal level, got %v", captured[1].Level)
+	}
+	if _, ok := captured[1].Tags["fx.hook"]; ok {
+		t.Errorf("Expected no hook tag on a failed start, got %v", captured[1].Tags)
+	}
+}
+
+func TestWrap_RollbackFailure(t *testing.T) {
+	var captured []*sentry.Event
+	l := Wrap(nil, newHub(t, &captured))
+	l.LogEvent(&fxevent.RollingBack{StartErr: errors.New("boom")})
+	l.LogEvent(&fxevent.RolledBack{Err: errors.New("stop failed")})
+	if len(captured) != 2 || captured[0].Tags["fx.phase"] != "rollback" || captured[1].Tags["fx.phase"] != "rollback" {
+		t.Errorf("Expected rollback failures without a hook failure to be captured, got %v", captured)
+	}
+}
+
+func TestWrap_StopFailure(t *testing.T) {
+	var captured []*sentry.Event
+	l := Wrap(nil, newHub(t, &captured))
+	l.LogEvent(&fxevent.Stopped{})
+	l.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	if len(captured) != 1 || captured[0].Tags["fx.phase"] != "stop" {
+		t.Errorf("Expected one stop failure, got %v", captured)
+	}
+}
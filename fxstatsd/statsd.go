@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package fxstatsd emits statsd metrics from Fx lifecycle events.
+//
+// Wrap decorates an fxevent.Logger, such as one created by fxeventzerolog.New,
+// so that every event is both logged and recorded through any statsd client
+// implementing Client, including the DogStatsD client:
+//
+//	fx.WithLogger(func(logger *zerolog.Logger) fxevent.Logger {
+//		return fxstatsd.Wrap(fxeventzerolog.New(logger), client)
+//	})
+package fxstatsd
+
+import (
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// Client is the subset of a statsd client used by Logger. Its method set
+// matches github.com/DataDog/datadog-go/v5/statsd.ClientInterface, so a
+// DogStatsD client can be passed directly; other clients can be adapted with
+// a small wrapper. Tags are in the "key:value" form.
+type Client interface {
+	Timing(name string, value time.Duration, tags []string, rate float64) error
+	Incr(name string, tags []string, rate float64) error
+}
+
+// Metric names.
+const (
+	HookDuration  = "fx.hook.duration"
+	StartFailures = "fx.start.failures"
+	StopFailures  = "fx.stop.failures"
+	HookFailures  = "fx.hook.failures"
+)
+
+// Logger is an fxevent.Logger that emits statsd metrics for each event
+// before forwarding it to the wrapped logger.
+type Logger struct {
+	next   fxevent.Logger
+	client Client
+	prefix string
+	tags   []string
+}
+
+var _ fxevent.Logger = (*Logger)(nil)
+
+// Option configures a Logger created by Wrap.
+type Option func(*Logger)
+
+// WithPrefix prepends prefix to every metric name, such as "myapp.".
+func WithPrefix(prefix string) Option {
+	return func(l *Logger) {
+		l.prefix = prefix
+	}
+}
+
+// WithTags adds tags, in the "key:value" form, to every metric.
+func WithTags(tags ...string) Option {
+	return func(l *Logger) {
+		l.tags = append(l.tags, tags...)
+	}
+}
+
+// Wrap returns a Logger that emits metrics to client and forwards events to
+// next. next may be nil, in which case events are only recorded. It emits:
+//
+//   - fx.hook.duration: timing of each OnStart and OnStop hook, tagged with
+//     hook:<function> and phase:start or phase:stop
+//   - fx.hook.failures: counter of failed hooks, with the same tags
+//   - fx.start.failures: counter of failed application starts
+//   - fx.stop.failures: counter of failed application stops
+//
+// Errors returned by client are ignored, so metrics never affect the
+// application lifecycle.
+func Wrap(next fxevent.Logger, client Client, opts ...Option) *Logger {
+	l := &Logger{next: next, client: client}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// LogEvent emits metrics for event and forwards it to the wrapped logger.
+func (l *Logger) LogEvent(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		l.hook("start", e.FunctionName, e.Runtime, e.Err)
+	case *fxevent.OnStopExecuted:
+		l.hook("stop", e.FunctionName, e.Runtime, e.Err)
+	case *fxevent.Started:
+		if e.Err != nil {
+			_ = l.client.Incr(l.prefix+StartFailures, l.tags, 1)
+		}
+	case *fxevent.Stopped:
+		if e.Err != nil {
+			_ = l.client.Incr(l.prefix+StopFailures, l.tags, 1)
+		}
+	}
+
+	if l.next != nil {
+		l.next.LogEvent(event)
+	}
+}
+
+// hook emits the timing of a hook and, if it failed, a failure count.
+func (l *Logger) hook(phase, function string, runtime time.Duration, err error) {
+	tags := append(l.tags[:len(l.tags):len(l.tags)], "hook:"+function, "phase:"+phase)
+	_ = l.client.Timing(l.prefix+HookDuration, runtime, tags, 1)
+	if err != nil {
+		_ = l.client.Incr(l.prefix+HookFailures, tags, 1)
+	}
+}
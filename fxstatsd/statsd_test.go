@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxstatsd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// fakeClient records each call as a string.
+type fakeClient struct {
+	calls []string
+}
+
+func (c *fakeClient) Timing(name string, value time.Duration, tags []string, _ float64) error {
+	c.calls = append(c.calls, fmt.Sprintf("timing %s %v %v", name, value, tags))
+	return nil
+}
+
+func (c *fakeClient) Incr(name string, tags []string, _ float64) error {
+	c.calls = append(c.calls, fmt.Sprintf("incr %s %v", name, tags))
+	return errors.New("ignored")
+}
+
+type recorder struct {
+	events []fxevent.Event
+}
+
+func (r *recorder) LogEvent(e fxevent.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestWrap(t *testing.T) {
+	client := &fakeClient{}
+	next := &recorder{}
+	l := Wrap(next, client, WithPrefix("app."), WithTags("env:test"))
+
+	events := []fxevent.Event{
+		&fxevent.OnStartExecuted{FunctionName: "a", Runtime: time.Second},
+		&fxevent.OnStopExecuted{FunctionName: "b", Runtime: time.Millisecond, Err: errors.New("fail")},
+		&fxevent.Started{Err: errors.New("fail")},
+		&fxevent.Stopped{Err: errors.New("fail")},
+		&fxevent.Stopped{},
+	}
+	for _, e := range events {
+		l.LogEvent(e)
+	}
+	if len(next.events) != len(events) {
+		t.Errorf("Expected all events to be forwarded, got %d", len(next.events))
+	}
+
+	want := []string{
+		"timing app.fx.hook.duration 1s [env:test hook:a phase:start]",
+		"timing app.fx.hook.duration 1ms [env:test hook:b phase:stop]",
+		"incr app.fx.hook.failures [env:test hook:b phase:stop]",
+		"incr app.fx.start.failures [env:test]",
+		"incr app.fx.stop.failures [env:test]",
+	}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("Expected calls %v, got %v", want, client.calls)
+	}
+}
+
+func TestWrap_NilNext(t *testing.T) {
+	client := &fakeClient{}
+	Wrap(nil, client).LogEvent(&fxevent.OnStartExecuted{FunctionName: "a"})
+	if len(client.calls) != 1 || client.calls[0] != "timing fx.hook.duration 0s [hook:a phase:start]" {
+		t.Errorf("Expected an untagged timing, got %v", client.calls)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// graphNode kinds.
+const (
+	constructorNode = "constructor"
+	decoratorNode   = "decorator"
+	typeNode        = "type"
+)
+
+// graphNode is a constructor, decorator or type in the dependency graph.
+type graphNode struct {
+	name    string
+	kind    string
+	module  string        // innermost module, empty for the root
+	private bool          // provided with fx.Private
+	runtime time.Duration // from the Run event, if the node was executed
+}
+
+// graphEdge links a constructor or decorator to a type.
+type graphEdge struct {
+	from, to string
+	kind     string // "provides" or "decorates"
+}
+
+// depGraph accumulates the dependency graph wired by fx from Provided,
+// Supplied, Decorated and Run events.
+type depGraph struct {
+	nodes   map[string]*graphNode // by kind and name
+	order   []*graphNode          // nodes in the order they were first seen
+	edges   []graphEdge
+	modules map[string]string // module name to parent module name
+	names   *nameCache        // parses module traces, if shared with a Logger
+}
+
+func newDepGraph() *depGraph {
+	return &depGraph{
+		nodes:   make(map[string]*graphNode),
+		modules: make(map[string]string),
+	}
+}
+
+// observe records event in the graph.
+func (g *depGraph) observe(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		if e.Err != nil {
+			return
+		}
+		g.trace(e.ModuleName, e.ModuleTrace)
+		ctor := g.node(constructorNode, e.ConstructorName, e.ModuleName)
+		ctor.private = e.Private
+		for _, t := range e.OutputTypeNames {
+			g.node(typeNode, t, e.ModuleName).private = e.Private
+			g.edge(ctor.name, t, "provides")
+		}
+	case *fxevent.Supplied:
+		if e.Err != nil {
+			return
+		}
+		g.trace(e.ModuleName, e.ModuleTrace)
+		g.node(typeNode, e.TypeName, e.ModuleName)
+	case *fxevent.Decorated:
+		if e.Err != nil {
+			return
+		}
+		g.trace(e.ModuleName, e.ModuleTrace)
+		dec := g.node(decoratorNode, e.DecoratorName, e.ModuleName)
+		for _, t := range e.OutputTypeNames {
+			g.node(typeNode, t, e.ModuleName)
+			g.edge(dec.name, t, "decorates")
+		}
+	case *fxevent.Run:
+		kind := constructorNode
+		if e.Kind == "decorate" {
+			kind = decoratorNode
+		}
+		if n, ok := g.nodes[kind+":"+e.Name]; ok && e.Err == nil {
+			n.runtime = e.Runtime
+		}
+	}
+}
+
+// node returns the node of the given kind and name, creating it if needed.
+func (g *depGraph) node(kind, name, module string) *graphNode {
+	key := kind + ":" + name
+	n, ok := g.nodes[key]
+	if !ok {
+		n = &graphNode{name: name, kind: kind, module: module}
+		g.nodes[key] = n
+		g.order = append(g.order, n)
+	}
+	return n
+}
+
+// edge adds an edge unless it is already present.
+func (g *depGraph) edge(from, to, kind string) {
+	e := graphEdge{from: from, to: to, kind: kind}
+	if !slices.Contains(g.edges, e) {
+		g.edges = append(g.edges, e)
+	}
+}
+
+// trace records the module nesting found in a module trace.
+func (g *depGraph) trace(module string, trace []string) {
+	names := g.names.traceModules(trace)
+	if len(module) > 0 && !slices.Contains(names, module) {
+		names = append([]string{module}, names...)
+	}
+	for i, name := range names {
+		var parent string
+		if i+1 < len(names) {
+			parent = names[i+1]
+		}
+		if _, ok := g.modules[name]; !ok || len(parent) > 0 {
+			g.modules[name] = parent
+		}
+	}
+}
+
+// children returns the modules nested directly within parent, sorted.
+func (g *depGraph) children(parent string) []string {
+	var names []string
+	for name, p := range g.modules {
+		if p == parent {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// dot renders the graph in Graphviz DOT format. Modules are drawn as nested
+// clusters, constructors and decorators as boxes and types as ellipses. Node
+// names are shown as rename returns them, given the node's kind, if set.
+func (g *depGraph) dot(rename func(kind, name string) string) string {
+	if rename == nil {
+		rename = func(kind, name string) string { return name }
+	}
+	var b strings.Builder
+	b.WriteString("digraph fx {\n\trankdir=LR;\n")
+	g.dotModule(&b, rename, "", 1)
+	for _, e := range g.edges {
+		from := constructorNode
+		if e.kind == "decorates" {
+			from = decoratorNode
+		}
+		fmt.Fprintf(&b, "\t%s -> %s", dotID(rename(from, e.from)), dotID(rename(typeNode, e.to)))
+		if e.kind == "decorates" {
+			b.WriteString(" [style=dashed, label=\"decorates\"]")
+		}
+		b.WriteString(";\n")
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotModule writes the nodes of a module and its nested modules as clusters.
+func (g *depGraph) dotModule(b *strings.Builder, rename func(kind, name string) string, module string, depth int) {
+	indent := strings.Repeat("\t", depth)
+	for _, n := range g.order {
+		if n.module != module {
+			continue
+		}
+		name := rename(n.kind, n.name)
+		label := strconv.Quote(name)
+		if n.runtime > 0 {
+			// Append the runtime on a second line using DOT's \n escape.
+			label = label[:len(label)-1] + `\n` + n.runtime.String() + `"`
+		}
+		shape := "ellipse"
+		if n.kind != typeNode {
+			shape = "box"
+		}
+		fmt.Fprintf(b, "%s%s [shape=%s, label=%s", indent, dotID(name), shape, label)
+		if n.private {
+			b.WriteString(", style=dashed")
+		}
+		b.WriteString("];\n")
+	}
+	for _, child := range g.children(module) {
+		fmt.Fprintf(b, "%ssubgraph %s {\n%s\tlabel=%s;\n", indent, dotID("cluster_"+child), indent, strconv.Quote(child))
+		g.dotModule(b, rename, child, depth+1)
+		fmt.Fprintf(b, "%s}\n", indent)
+	}
+}
+
+// dotID quotes a string for use as a DOT identifier.
+func dotID(s string) string {
+	return strconv.Quote(s)
+}
+
+// jsonGraph is the JSON representation of a depGraph.
+type jsonGraph struct {
+	Nodes   []jsonNode   `json:"nodes"`
+	Edges   []jsonEdge   `json:"edges"`
+	Modules []jsonModule `json:"modules"`
+}
+
+type jsonNode struct {
+	ID      string        `json:"id"`
+	Kind    string        `json:"kind"`
+	Module  string        `json:"module,omitempty"`
+	Private bool          `json:"private"`
+	Runtime time.Duration `json:"runtime_ns,omitempty"`
+}
+
+type jsonEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+type jsonModule struct {
+	Name   string `json:"name"`
+	Parent string `json:"parent,omitempty"`
+}
+
+// json returns the JSON representation of the graph. Nodes and edges are in
+// the order they were first seen; modules are sorted by name.
+func (g *depGraph) json() jsonGraph {
+	out := jsonGraph{
+		Nodes:   make([]jsonNode, 0, len(g.order)),
+		Edges:   make([]jsonEdge, 0, len(g.edges)),
+		Modules: make([]jsonModule, 0, len(g.modules)),
+	}
+	for _, n := range g.order {
+		out.Nodes = append(out.Nodes, jsonNode{ID: n.name, Kind: n.kind, Module: n.module, Private: n.private, Runtime: n.runtime})
+	}
+	for _, e := range g.edges {
+		out.Edges = append(out.Edges, jsonEdge{From: e.from, To: e.to, Kind: e.kind})
+	}
+	for _, name := range slices.Sorted(maps.Keys(g.modules)) {
+		out.Modules = append(out.Modules, jsonModule{Name: name, Parent: g.modules[name]})
+	}
+	return out
+}
+
+// GraphDOT returns the dependency graph observed so far in Graphviz DOT
+// format. The graph is only accumulated when the Logger was created with
+// WithGraph or one of the options that export it.
+func (l *Logger) GraphDOT() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.deps == nil {
+		return newDepGraph().dot(nil)
+	}
+	return l.deps.dot(nil)
+}
+
+// GraphJSON returns the dependency graph observed so far as JSON, with the
+// nodes (constructors, decorators and types), the edges between them and the
+// module hierarchy, suitable for diffing wiring between releases. Like
+// GraphDOT, it requires WithGraph.
+func (l *Logger) GraphJSON() ([]byte, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	g := l.deps
+	if g == nil {
+		g = newDepGraph()
+	}
+	return json.Marshal(g.json())
+}
+
+// exportGraph logs or writes the DOT graph as configured, once started. The
+// graph shows names as records do, redacted and hashed as configured.
+func (l *Logger) exportGraph() {
+	if l.deps == nil {
+		return
+	}
+	dot := l.deps.dot(l.publicName)
+	if l.graphLog {
+		l.log().Str("dot", dot).Send(KindDependencyGraph)
+	}
+	if len(l.graphFile) > 0 {
+		if err := os.WriteFile(l.graphFile, []byte(dot), 0o644); err != nil {
+			l.err().Str("path", l.graphFile).Err(err).Send(KindGraphWriteFailed)
+		}
+	}
+}
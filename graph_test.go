@@ -0,0 +1,126 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func graphEvents() []fxevent.Event {
+	return []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "main.NewDB()", OutputTypeNames: []string{"*sql.DB"}, ModuleName: "db",
+			ModuleTrace: []string{"main.NewDB (db.go:1)", "main.main (main.go:5) (db)", "main.main (main.go:3) (app)"}},
+		&fxevent.Provided{ConstructorName: "main.NewCfg()", OutputTypeNames: []string{"main.Config"}, Private: true},
+		&fxevent.Decorated{DecoratorName: "main.Wrap()", OutputTypeNames: []string{"*sql.DB"}, ModuleName: "app"},
+		&fxevent.Run{Name: "main.NewDB()", Kind: "provide", Runtime: 5 * time.Millisecond},
+		&fxevent.Started{},
+	}
+}
+
+func TestLogger_GraphDOT(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithGraph())
+	for _, e := range graphEvents() {
+		logger.LogEvent(e)
+	}
+	dot := logger.GraphDOT()
+	for _, want := range []string{
+		"digraph fx {",
+		`"main.NewCfg()" [shape=box, label="main.NewCfg()", style=dashed];`,
+		`subgraph "cluster_app" {`,
+		`		subgraph "cluster_db" {`,
+		`"main.NewDB()" [shape=box, label="main.NewDB()\n5ms"];`,
+		`"*sql.DB" [shape=ellipse, label="*sql.DB"];`,
+		`"main.NewDB()" -> "*sql.DB";`,
+		`"main.Wrap()" -> "*sql.DB" [style=dashed, label="decorates"];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("Expected DOT to contain %s, got:\n%s", want, dot)
+		}
+	}
+}
+
+func TestLogger_GraphDOT_Disabled(t *testing.T) {
+	logger, _ := newTestLogger()
+	logger.LogEvent(graphEvents()[0])
+	if dot := logger.GraphDOT(); strings.Contains(dot, "NewDB") {
+		t.Errorf("Expected empty graph without WithGraph, got %s", dot)
+	}
+}
+
+func TestWithGraphLogAndFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.dot")
+	logger, buf := newTestLoggerWith(WithGraphLog(), WithGraphFile(path))
+	for _, e := range graphEvents() {
+		logger.LogEvent(e)
+	}
+	if !strings.Contains(buf.String(), `"message":"dependency graph"`) || !strings.Contains(buf.String(), `"dot":"digraph fx`) {
+		t.Errorf("Expected dependency graph record, got %s", buf.String())
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != logger.GraphDOT() {
+		t.Errorf("Expected file to contain the DOT graph, got %s", b)
+	}
+}
+
+func TestTraceModules(t *testing.T) {
+	got := traceModules([]string{"main.New (main.go:1)", "main.main (main.go:5) (inner)", "main.main (main.go:3) (outer)"})
+	if len(got) != 2 || got[0] != "inner" || got[1] != "outer" {
+		t.Errorf("traceModules() = %v, want [inner outer]", got)
+	}
+}
+
+func TestLogger_GraphJSON(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithGraph())
+	for _, e := range graphEvents() {
+		logger.LogEvent(e)
+	}
+	b, err := logger.GraphJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var g struct {
+		Nodes []struct {
+			ID      string `json:"id"`
+			Kind    string `json:"kind"`
+			Module  string `json:"module"`
+			Private bool   `json:"private"`
+			Runtime int64  `json:"runtime_ns"`
+		} `json:"nodes"`
+		Edges []struct {
+			From, To, Kind string
+		} `json:"edges"`
+		Modules []struct {
+			Name   string `json:"name"`
+			Parent string `json:"parent"`
+		} `json:"modules"`
+	}
+	if err := json.Unmarshal(b, &g); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Nodes) != 5 || len(g.Edges) != 3 || len(g.Modules) != 2 {
+		t.Fatalf("Unexpected graph shape: %s", b)
+	}
+	if n := g.Nodes[0]; n.ID != "main.NewDB()" || n.Kind != "constructor" || n.Module != "db" || n.Runtime != int64(5*time.Millisecond) {
+		t.Errorf("Unexpected first node: %+v", n)
+	}
+	if n := g.Nodes[2]; n.ID != "main.NewCfg()" || !n.Private {
+		t.Errorf("Expected private constructor node, got %+v", n)
+	}
+	if e := g.Edges[2]; e.From != "main.Wrap()" || e.To != "*sql.DB" || e.Kind != "decorates" {
+		t.Errorf("Unexpected decorator edge: %+v", e)
+	}
+	if m := g.Modules[1]; m.Name != "db" || m.Parent != "app" {
+		t.Errorf("Unexpected module: %+v", m)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// hookRuntime is the runtime of a single executed lifecycle hook.
+type hookRuntime struct {
+	callee  string
+	caller  string
+	runtime time.Duration
+}
+
+// observeHooks records an executed hook in the hook reports, whether or not
+// its record is logged.
+func (l *Logger) observeHooks(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		if l.hookDist != nil {
+			l.hookDist.observe(e.Runtime)
+		}
+		if l.slowStart != nil {
+			l.slowStart.observe(hookRuntime{callee: e.FunctionName, caller: e.CallerName, runtime: e.Runtime})
+		}
+	case *fxevent.OnStopExecuted:
+		if l.hookDist != nil {
+			l.hookDist.observe(e.Runtime)
+		}
+		if l.slowStop != nil {
+			l.slowStop.observe(hookRuntime{callee: e.FunctionName, caller: e.CallerName, runtime: e.Runtime})
+		}
+	}
+}
+
+// slowestHooks keeps the n slowest hooks observed, slowest first.
+type slowestHooks struct {
+	n     int
+	hooks []hookRuntime
+}
+
+// observe records an executed hook.
+func (s *slowestHooks) observe(h hookRuntime) {
+	// Insert after any hooks at least as slow, keeping earlier hooks first
+	// among equals.
+	i := sort.Search(len(s.hooks), func(i int) bool {
+		return s.hooks[i].runtime < h.runtime
+	})
+	if i >= s.n {
+		return
+	}
+	s.hooks = slices.Insert(s.hooks, i, h)
+	if len(s.hooks) > s.n {
+		s.hooks = s.hooks[:s.n]
+	}
+}
+
+// fields adds the slowest hooks to the entry as an array of objects.
+func (s *slowestHooks) fields(e *entry) *entry {
+	hooks := make([]*entry, len(s.hooks))
+	for i, h := range s.hooks {
+		hooks[i] = (&entry{}).Str("callee", h.callee).Str("caller", h.caller).Dur("runtime", h.runtime)
+	}
+	return e.Dicts("hooks", hooks)
+}
+
+// runtimeBuckets are the upper bounds of the hook runtime distribution
+// buckets. Runtimes above the last bound are counted in a "+Inf" bucket.
+var runtimeBuckets = []time.Duration{
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// runtimeDistribution collects hook runtimes to summarize their distribution.
+type runtimeDistribution struct {
+	runtimes []time.Duration
+}
+
+// observe records a hook runtime.
+func (d *runtimeDistribution) observe(runtime time.Duration) {
+	d.runtimes = append(d.runtimes, runtime)
+}
+
+// fields adds the count, min, p50, p95 and max runtimes and the bucketed
+// counts to the entry. Bucket counts are not cumulative: each runtime is
+// counted in the first bucket whose bound it does not exceed.
+func (d *runtimeDistribution) fields(e *entry) *entry {
+	e = e.Int("count", len(d.runtimes))
+	if len(d.runtimes) == 0 {
+		return e
+	}
+	sorted := slices.Sorted(slices.Values(d.runtimes))
+	e = e.Dur("min", sorted[0]).
+		Dur("p50", percentile(sorted, 50)).
+		Dur("p95", percentile(sorted, 95)).
+		Dur("max", sorted[len(sorted)-1])
+
+	counts := make([]int, len(runtimeBuckets)+1)
+	for _, rt := range sorted {
+		i, _ := slices.BinarySearch(runtimeBuckets, rt)
+		counts[i]++
+	}
+	buckets := &entry{}
+	for i, bound := range runtimeBuckets {
+		buckets.Int(bound.String(), counts[i])
+	}
+	buckets.Int("+Inf", counts[len(runtimeBuckets)])
+	return e.Dict("buckets", buckets)
+}
+
+// percentile returns the p-th percentile of sorted using the nearest-rank
+// method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
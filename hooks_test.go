@@ -0,0 +1,113 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestSlowestHooks(t *testing.T) {
+	s := &slowestHooks{n: 3}
+	for i, d := range []time.Duration{5, 1, 9, 3, 7, 9} {
+		s.observe(hookRuntime{callee: string(rune('a' + i)), runtime: d})
+	}
+	var got []time.Duration
+	for _, h := range s.hooks {
+		got = append(got, h.runtime)
+	}
+	if want := []time.Duration{9, 9, 7}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Errorf("slowest hooks = %v, want %v", got, want)
+	}
+	if s.hooks[0].callee != "c" {
+		t.Errorf("Expected earlier hook first among equals, got %s", s.hooks[0].callee)
+	}
+}
+
+func TestWithSlowestStartHooks(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSlowestStartHooks(2))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "fast", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "slow", CallerName: "c", Runtime: time.Second})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "medium", CallerName: "c", Runtime: 50 * time.Millisecond})
+	logger.LogEvent(&fxevent.Started{})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	report := lines[len(lines)-2]
+	want := `"hooks":[{"callee":"slow","caller":"c","runtime":"1s"},{"callee":"medium","caller":"c","runtime":"50ms"}],"message":"slowest OnStart hooks"`
+	if !strings.Contains(report, want) {
+		t.Errorf("Expected report %s, got %s", want, report)
+	}
+}
+
+func TestWithSlowestStartHooks_Quiet(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetQuiet(), WithSlowestStartHooks(1))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "slow", CallerName: "c", Runtime: time.Second})
+	logger.LogEvent(&fxevent.Started{})
+	if !strings.Contains(buf.String(), `"hooks":[{"callee":"slow","caller":"c","runtime":"1s"}]`) {
+		t.Errorf("Expected hooks swallowed by PresetQuiet to be reported, got %s", buf.String())
+	}
+}
+
+func TestWithSlowestStopHooks(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSlowestStopHooks(1))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "start", CallerName: "c", Runtime: time.Hour})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "fast", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "slow", CallerName: "c", Runtime: time.Second})
+	logger.LogEvent(&fxevent.Stopped{})
+	out := buf.String()
+	want := `"hooks":[{"callee":"slow","caller":"c","runtime":"1s"}],"message":"slowest OnStop hooks"`
+	if !strings.Contains(out, want) {
+		t.Errorf("Expected report %s, got %s", want, out)
+	}
+}
+
+func TestWithSlowestStopHooks_RateLimit(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetQuiet(), WithRateLimit(0, 1), WithSlowestStopHooks(5))
+	for _, name := range []string{"a", "b", "c"} {
+		logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: name, CallerName: "c", Runtime: time.Second})
+	}
+	logger.LogEvent(&fxevent.Stopped{})
+	if n := strings.Count(buf.String(), `"runtime":"1s"`); n != 3 {
+		t.Errorf("Expected all 3 hooks to be reported, got %d in %s", n, buf.String())
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got := percentile(sorted, 50); got != 5 {
+		t.Errorf("p50 = %v, want 5", got)
+	}
+	if got := percentile(sorted, 95); got != 10 {
+		t.Errorf("p95 = %v, want 10", got)
+	}
+	if got := percentile(sorted[:1], 50); got != 1 {
+		t.Errorf("p50 of one = %v, want 1", got)
+	}
+}
+
+func TestWithHookRuntimeDistribution(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithHookRuntimeDistribution())
+	for _, d := range []time.Duration{500 * time.Microsecond, time.Millisecond, 50 * time.Millisecond, 2 * time.Second} {
+		logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Runtime: d})
+	}
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Minute})
+	logger.LogEvent(&fxevent.Stopped{})
+	want := `"count":5,"min":"500µs","p50":"50ms","p95":"1m0s","max":"1m0s",` +
+		`"buckets":{"1ms":2,"10ms":0,"100ms":1,"1s":0,"10s":1,"+Inf":1},"message":"hook runtime distribution"`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("Expected %s, got %s", want, buf.String())
+	}
+}
+
+func TestWithHookRuntimeDistribution_Quiet(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetQuiet(), WithHookRuntimeDistribution())
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Second})
+	logger.LogEvent(&fxevent.Stopped{})
+	if !strings.Contains(buf.String(), `"count":2,`) {
+		t.Errorf("Expected hooks swallowed by PresetQuiet to be counted, got %s", buf.String())
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+// Package term detects terminals, for the console output of fxeventzerolog
+// and the colors of fxlogview.
+package term
+
+import (
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// IsTerminal reports whether w is a terminal, including Cygwin and MSYS2
+// terminals on Windows. Other character devices, such as /dev/null, are not
+// terminals.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fd := f.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
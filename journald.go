@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "github.com/rs/zerolog"
+
+// journaldPriority maps a zerolog level to a syslog priority as used by
+// journald, following zerolog's syslog writer.
+func journaldPriority(lvl zerolog.Level) string {
+	switch lvl {
+	case zerolog.FatalLevel:
+		return "0" // emerg
+	case zerolog.PanicLevel:
+		return "2" // crit
+	case zerolog.ErrorLevel:
+		return "3" // err
+	case zerolog.WarnLevel:
+		return "4" // warning
+	case zerolog.TraceLevel, zerolog.DebugLevel:
+		return "7" // debug
+	default:
+		return "6" // info
+	}
+}
+
+// journaldFields adds the PRIORITY and, if configured, SYSLOG_IDENTIFIER
+// fields to an entry.
+func journaldFields(e *entry) {
+	e.Str("PRIORITY", journaldPriority(e.level))
+	if len(e.l.identifier) > 0 {
+		e.Str("SYSLOG_IDENTIFIER", e.l.identifier)
+	}
+}
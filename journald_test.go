@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithJournaldFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithJournaldFields("myapp"))
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, `"PRIORITY":"6","SYSLOG_IDENTIFIER":"myapp"`) {
+		t.Errorf("Expected info priority and identifier, got %s", out)
+	}
+
+	buf.Reset()
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), `"PRIORITY":"3"`) {
+		t.Errorf("Expected err priority, got %s", buf.String())
+	}
+}
+
+func TestWithJournaldFields_NoIdentifier(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithJournaldFields(""), WithSyslogSeverities())
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	out := buf.String()
+	if strings.Contains(out, "SYSLOG_IDENTIFIER") {
+		t.Errorf("Expected no identifier, got %s", out)
+	}
+	if !strings.Contains(out, `"PRIORITY":"2"`) {
+		t.Errorf("Expected crit priority for a failed start, got %s", out)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// fieldLimits caps the size of string and array fields.
+type fieldLimits struct {
+	elements int // maximum elements of a string array, or 0
+	length   int // maximum length of a string in bytes, or 0
+}
+
+// limitFields truncates the entry's oversized fields.
+func (lim *fieldLimits) limitFields(fields []field) {
+	for i := range fields {
+		f := &fields[i]
+		switch f.kind {
+		case stringField:
+			f.str = lim.limitString(f.str)
+		case stringsField:
+			f.strs = lim.limitStrings(f.strs)
+		case objectField, arrayField:
+			lim.limitFields(f.sub)
+		}
+	}
+}
+
+// limitString truncates s to the length limit at a rune boundary, marking
+// how many bytes were cut.
+func (lim *fieldLimits) limitString(s string) string {
+	if lim.length <= 0 || len(s) <= lim.length {
+		return s
+	}
+	n := lim.length
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "...(" + strconv.Itoa(len(s)-n) + " bytes truncated)"
+}
+
+// limitStrings truncates strs to the element limit, replacing the dropped
+// elements with a marker, and truncates each element. strs itself is not
+// modified, as it belongs to the event.
+func (lim *fieldLimits) limitStrings(strs []string) []string {
+	over := lim.elements > 0 && len(strs) > lim.elements
+	long := false
+	for _, s := range strs {
+		long = long || (lim.length > 0 && len(s) > lim.length)
+	}
+	if !over && !long {
+		return strs
+	}
+	n := len(strs)
+	if over {
+		n = lim.elements
+	}
+	out := make([]string, n, n+1)
+	for i, s := range strs[:n] {
+		out[i] = lim.limitString(s)
+	}
+	if over {
+		out = append(out, "...("+strconv.Itoa(len(strs)-n)+" more)")
+	}
+	return out
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithFieldLimits(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithFieldLimits(2, 10))
+	stack := []string{"a.go:1", "b.go:2", "c.go:3", "d.go:4"}
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "main.NewCache[map[string]int]()",
+		OutputTypeNames: []string{"*main.Cache[map[string]int]"},
+		StackTrace:      stack,
+	})
+	out := buf.String()
+	if !strings.Contains(out, `"stacktrace":["a.go:1","b.go:2","...(2 more)"]`) {
+		t.Errorf("Expected a truncated stack trace, got %s", out)
+	}
+	if !strings.Contains(out, `"constructor":"main.NewCa...(21 bytes truncated)"`) {
+		t.Errorf("Expected a truncated constructor name, got %s", out)
+	}
+	if !slices.Equal(stack, []string{"a.go:1", "b.go:2", "c.go:3", "d.go:4"}) {
+		t.Errorf("Expected the event's stack trace to be left unchanged, got %v", stack)
+	}
+}
+
+func TestFieldLimits_RuneBoundary(t *testing.T) {
+	lim := &fieldLimits{length: 2}
+	if got := lim.limitString("héllo"); got != "h...(5 bytes truncated)" {
+		t.Errorf("Expected truncation at a rune boundary, got %q", got)
+	}
+	if got := lim.limitString("hi"); got != "hi" {
+		t.Errorf("Expected short strings to be kept, got %q", got)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rs/zerolog"
+)
+
+// logfmtSink is an EventSink that writes records as logfmt lines.
+type logfmtSink struct {
+	w     io.Writer
+	level zerolog.Level
+	now   func() time.Time
+	buf   []byte
+}
+
+// NewLogfmtSink returns an EventSink that writes records at level or above
+// to w as logfmt: one line of space-separated key=value pairs per record,
+// starting with time, level and msg. Values containing spaces, quotes,
+// equals signs or control characters are quoted, nested objects are
+// flattened into dotted keys such as by_module.db.constructors, and arrays
+// of objects are indexed, as in hooks.0.callee.
+func NewLogfmtSink(w io.Writer, level zerolog.Level) EventSink {
+	return &logfmtSink{w: w, level: level, now: time.Now}
+}
+
+// NewLogfmt returns a Logger configured by opts that writes info and higher
+// records to w as logfmt, for log pipelines that ingest logfmt rather than
+// JSON. See NewLogfmtSink for the format.
+func NewLogfmt(w io.Writer, opts ...Option) *Logger {
+	return NewWithSink(NewLogfmtSink(w, zerolog.InfoLevel), opts...)
+}
+
+// Write encodes r as a logfmt line.
+func (s *logfmtSink) Write(r Record) {
+	if r.Level < s.level {
+		return
+	}
+	b := s.buf[:0]
+	b = append(b, zerolog.TimestampFieldName...)
+	b = append(b, '=')
+	b = s.now().AppendFormat(b, time.RFC3339Nano)
+	if r.Level != zerolog.NoLevel {
+		b = append(b, ' ')
+		b = append(b, zerolog.LevelFieldName...)
+		b = append(b, '=')
+		b = append(b, r.Level.String()...)
+	}
+	b = append(b, " msg="...)
+	b = appendLogfmtString(b, r.Message)
+	b = appendLogfmt(b, "", recordFields(r.Fields))
+	b = append(b, '\n')
+	s.w.Write(b)
+	if cap(b) <= maxDetailBuffer {
+		s.buf = b
+	}
+}
+
+// appendLogfmt appends fields to b as key=value pairs, each preceded by a
+// space, with their keys prefixed by prefix.
+func appendLogfmt(b []byte, prefix string, fields []field) []byte {
+	for _, f := range fields {
+		key := prefix + logfmtKey(f.key)
+		switch f.kind {
+		case objectField:
+			b = appendLogfmt(b, key+".", f.sub)
+			continue
+		case arrayField:
+			for i, obj := range f.sub {
+				b = appendLogfmt(b, key+"."+strconv.Itoa(i)+".", obj.sub)
+			}
+			continue
+		}
+		b = append(b, ' ')
+		b = append(b, key...)
+		b = append(b, '=')
+		switch f.kind {
+		case stringsField:
+			b = appendLogfmtString(b, strings.Join(f.strs, ","))
+		case boolField:
+			b = strconv.AppendBool(b, f.num != 0)
+		case intField:
+			b = strconv.AppendInt(b, f.num, 10)
+		case floatField:
+			b = strconv.AppendFloat(b, f.flt, 'g', -1, 64)
+		case durationField:
+			b = append(b, time.Duration(f.num).String()...)
+		case errorField:
+			if f.err == nil {
+				b = append(b, `""`...)
+			} else {
+				b = appendLogfmtString(b, f.err.Error())
+			}
+		default:
+			b = appendLogfmtString(b, f.str)
+		}
+	}
+	return b
+}
+
+// appendLogfmtString appends s to b, quoted and escaped if it is empty or
+// contains spaces, quotes, equals signs, control characters or invalid
+// UTF-8.
+func appendLogfmtString(b []byte, s string) []byte {
+	if len(s) == 0 || !utf8.ValidString(s) || strings.ContainsFunc(s, func(r rune) bool {
+		return r <= ' ' || r == '"' || r == '=' || r == '\\' || r == 0x7f
+	}) {
+		return strconv.AppendQuote(b, s)
+	}
+	return append(b, s...)
+}
+
+// logfmtKey replaces the characters logfmt does not allow in keys, spaces,
+// quotes, equals signs and control characters, with underscores.
+func logfmtKey(key string) string {
+	if len(key) == 0 {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '"' || r == '=' || r == 0x7f {
+			return '_'
+		}
+		return r
+	}, key)
+}
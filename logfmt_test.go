@@ -0,0 +1,75 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func newTestLogfmt(level zerolog.Level, opts ...Option) (*Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	sink := NewLogfmtSink(buf, level).(*logfmtSink)
+	sink.now = func() time.Time { return time.Date(2025, 1, 2, 10, 0, 0, 123000000, time.UTC) }
+	return NewWithSink(sink, opts...), buf
+}
+
+func TestNewLogfmt(t *testing.T) {
+	logger, buf := newTestLogfmt(zerolog.InfoLevel)
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: 1500 * time.Microsecond})
+	want := "time=2025-01-02T10:00:00.123Z level=info msg=\"OnStart hook executed\" callee=main.start() caller=main.New() runtime=1.5ms\n"
+	if buf.String() != want {
+		t.Errorf("Expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestNewLogfmt_Escaping(t *testing.T) {
+	logger, buf := newTestLogfmt(zerolog.InfoLevel)
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("bad \"value\"\nsee=docs")})
+	out := buf.String()
+	if !strings.Contains(out, `error="bad \"value\"\nsee=docs"`) {
+		t.Errorf("Expected the error to be quoted and escaped, got %s", out)
+	}
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("Expected a single line, got %q", out)
+	}
+}
+
+func TestNewLogfmt_Level(t *testing.T) {
+	logger, buf := newTestLogfmt(zerolog.WarnLevel)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if buf.Len() > 0 {
+		t.Errorf("Expected info records to be filtered, got %s", buf.String())
+	}
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), `level=error msg="start failed" error=boom`) {
+		t.Errorf("Expected the error record, got %s", buf.String())
+	}
+}
+
+func TestAppendLogfmt(t *testing.T) {
+	fields := []field{
+		{key: "types", kind: stringsField, strs: []string{"*a", "*b"}},
+		{key: "private", kind: boolField, num: 1},
+		{key: "by_module", kind: objectField, sub: []field{
+			{key: "db", kind: objectField, sub: []field{{key: "constructors", kind: intField, num: 2}}},
+		}},
+		{key: "hooks", kind: arrayField, sub: []field{
+			{kind: objectField, sub: []field{{key: "callee", kind: stringField, str: "main.start()"}}},
+		}},
+		{key: "empty", kind: stringField},
+		{key: "odd key", kind: stringField, str: "x"},
+	}
+	got := string(appendLogfmt(nil, "", fields))
+	want := ` types=*a,*b private=true by_module.db.constructors=2 hooks.0.callee=main.start() empty="" odd_key=x`
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
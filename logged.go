@@ -0,0 +1,25 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// loggedRecord is a record written while handling an event, reported to the
+// WithOnLogged callbacks.
+type loggedRecord struct {
+	event fxevent.Event
+	level zerolog.Level
+}
+
+// notifyLogged calls the WithOnLogged callbacks for each written record.
+func (l *Logger) notifyLogged(records []loggedRecord) {
+	for _, r := range records {
+		for _, fn := range l.onLogged {
+			fn(r.event, r.level)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithOnLogged(t *testing.T) {
+	var got []string
+	logger, _ := newTestLoggerWith(WithShutdownSummary(), WithOnLogged(func(event fxevent.Event, level zerolog.Level) {
+		got = append(got, eventName(event)+"@"+level.String())
+	}))
+
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Stopped{})
+
+	want := []string{"OnStopExecuted@error", "Stopped@error"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Expected callbacks %v, got %v", want, got)
+	}
+}
+
+func TestWithOnLogged_SkipsFiltered(t *testing.T) {
+	var n int
+	zl := zerolog.New(&bytes.Buffer{}).Level(zerolog.WarnLevel)
+	logger := New(&zl, WithOnLogged(func(fxevent.Event, zerolog.Level) { n++ }))
+	logger.LogEvent(&fxevent.Started{})
+	if n != 0 {
+		t.Errorf("Expected no callback for a record below the logger's level, got %d", n)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// mermaidLabel escapes a string for use as a quoted Mermaid label, using
+// Mermaid's entity codes for the characters that would end or break it.
+var mermaidLabel = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "#", "#35;")
+
+// mermaidTask escapes a string for use as a Mermaid gantt task or section
+// name, in which colons, semicolons and hashes have a meaning of their own.
+var mermaidTask = strings.NewReplacer(":", " ", ";", " ", "#", " ")
+
+// mermaid renders the graph as a Mermaid flowchart. Modules are drawn as
+// nested subgraphs, constructors and decorators as boxes and types as
+// stadiums, with decorations as dotted edges.
+func (g *depGraph) mermaid() string {
+	ids := make(map[*graphNode]string, len(g.order))
+	for i, n := range g.order {
+		ids[n] = "n" + strconv.Itoa(i)
+	}
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	g.mermaidModule(&b, ids, "", 1)
+	for _, e := range g.edges {
+		from, to := g.edgeNodes(e)
+		if from == nil || to == nil {
+			continue
+		}
+		arrow := "-->"
+		if e.kind == "decorates" {
+			arrow = "-. decorates .->"
+		}
+		fmt.Fprintf(&b, "    %s %s %s\n", ids[from], arrow, ids[to])
+	}
+	return b.String()
+}
+
+// edgeNodes returns the constructor or decorator and the type an edge links.
+func (g *depGraph) edgeNodes(e graphEdge) (from, to *graphNode) {
+	kind := constructorNode
+	if e.kind == "decorates" {
+		kind = decoratorNode
+	}
+	return g.nodes[kind+":"+e.from], g.nodes[typeNode+":"+e.to]
+}
+
+// mermaidModule writes the nodes of a module and its nested modules as
+// subgraphs.
+func (g *depGraph) mermaidModule(b *strings.Builder, ids map[*graphNode]string, module string, depth int) {
+	indent := strings.Repeat("    ", depth)
+	for _, n := range g.order {
+		if n.module != module {
+			continue
+		}
+		label := mermaidLabel.Replace(n.name)
+		if n.runtime > 0 {
+			label += "<br>" + n.runtime.String()
+		}
+		open, end := `["`, `"]`
+		if n.kind == typeNode {
+			open, end = `(["`, `"])`
+		}
+		fmt.Fprintf(b, "%s%s%s%s%s\n", indent, ids[n], open, label, end)
+		if n.private {
+			fmt.Fprintf(b, "%sstyle %s stroke-dasharray: 5 5\n", indent, ids[n])
+		}
+	}
+	for _, child := range g.children(module) {
+		fmt.Fprintf(b, "%ssubgraph %s [\"%s\"]\n", indent, mermaidID("m", child), mermaidLabel.Replace(child))
+		g.mermaidModule(b, ids, child, depth+1)
+		fmt.Fprintf(b, "%send\n", indent)
+	}
+}
+
+// mermaidID returns a Mermaid identifier for name, made of prefix and the
+// name's letters and digits, so that it is stable across renders.
+func mermaidID(prefix, name string) string {
+	return prefix + "_" + strings.Map(func(r rune) rune {
+		if ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// mermaid renders the timeline as a Mermaid gantt chart with a section per
+// caller, at millisecond resolution: hooks shorter than a millisecond are
+// drawn a millisecond long, with their runtime in their name.
+func (t *startupTimeline) mermaid() string {
+	var b strings.Builder
+	b.WriteString("gantt\n    title Startup timeline\n    dateFormat x\n    axisFormat %S.%L\n")
+	section := ""
+	for i, s := range t.spans {
+		if i == 0 || s.caller != section {
+			section = s.caller
+			name := mermaidTask.Replace(section)
+			if len(name) == 0 {
+				name = "hooks"
+			}
+			fmt.Fprintf(&b, "    section %s\n", name)
+		}
+		start := s.offset.Milliseconds()
+		end := start + max(s.runtime.Milliseconds(), 1)
+		fmt.Fprintf(&b, "    %s (%s) :%d, %d\n", mermaidTask.Replace(s.callee), roundDuration(s.runtime), start, end)
+	}
+	return b.String()
+}
+
+// GraphMermaid returns the dependency graph observed so far as a Mermaid
+// flowchart, which GitHub and GitLab render in Markdown inside a ```mermaid
+// block. Like GraphDOT, it requires WithGraph.
+func (l *Logger) GraphMermaid() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.deps == nil {
+		return newDepGraph().mermaid()
+	}
+	return l.deps.mermaid()
+}
+
+// TimelineMermaid returns the startup timeline observed so far as a Mermaid
+// gantt chart of the OnStart hooks, grouped by the constructor that
+// registered them. The timeline is recorded with WithStartupTimeline,
+// WithStartupTraceFile or WithDebugState.
+func (l *Logger) TimelineMermaid() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case l.timeline != nil:
+		return l.timeline.mermaid()
+	case l.debug != nil:
+		return l.debug.timeline.mermaid()
+	default:
+		return newStartupTimeline().mermaid()
+	}
+}
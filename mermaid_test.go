@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestLogger_GraphMermaid(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithGraph())
+	for _, e := range graphEvents() {
+		logger.LogEvent(e)
+	}
+	out := logger.GraphMermaid()
+	for _, want := range []string{
+		"flowchart LR\n",
+		"    n2[\"main.NewCfg()\"]\n    style n2 stroke-dasharray: 5 5\n",
+		"    subgraph m_app [\"app\"]\n",
+		"        subgraph m_db [\"db\"]\n            n0[\"main.NewDB()<br>5ms\"]\n            n1([\"*sql.DB\"])\n        end\n",
+		"    n0 --> n1\n",
+		"    n4 -. decorates .-> n1\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected Mermaid to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestLogger_GraphMermaid_Disabled(t *testing.T) {
+	logger, _ := newTestLogger()
+	logger.LogEvent(graphEvents()[0])
+	if out := logger.GraphMermaid(); out != "flowchart LR\n" {
+		t.Errorf("Expected empty graph without WithGraph, got %s", out)
+	}
+}
+
+func TestMermaidLabel(t *testing.T) {
+	if got := mermaidLabel.Replace(`fx.Annotated[name:"a"]<T>#1`); got != "fx.Annotated[name:#quot;a#quot;]#lt;T#gt;#35;1" {
+		t.Errorf("Expected escaped label, got %s", got)
+	}
+}
+
+func TestStartupTimeline_Mermaid(t *testing.T) {
+	tl := newStartupTimeline()
+	begin := time.Unix(0, 0)
+	tl.observe(&fxevent.Provided{}, begin)
+	tl.observe(&fxevent.OnStartExecuted{FunctionName: "main.a()", CallerName: "main.New()", Runtime: 5 * time.Millisecond}, begin.Add(15*time.Millisecond))
+	tl.observe(&fxevent.OnStartExecuted{FunctionName: "main.b()", CallerName: "main.New()", Runtime: 200 * time.Microsecond}, begin.Add(20*time.Millisecond))
+	tl.observe(&fxevent.OnStartExecuted{FunctionName: "main.c()", CallerName: "main.Other()", Runtime: 3 * time.Millisecond}, begin.Add(30*time.Millisecond))
+
+	want := "gantt\n    title Startup timeline\n    dateFormat x\n    axisFormat %S.%L\n" +
+		"    section main.New()\n" +
+		"    main.a() (5ms) :10, 15\n" +
+		"    main.b() (200µs) :19, 20\n" +
+		"    section main.Other()\n" +
+		"    main.c() (3ms) :27, 30\n"
+	if got := tl.mermaid(); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestLogger_TimelineMermaid(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithDebugState(0))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.a()", CallerName: "main.New()", Runtime: 5 * time.Millisecond})
+	if out := logger.TimelineMermaid(); !strings.Contains(out, "    section main.New()\n    main.a() (5ms) :") {
+		t.Errorf("Expected the recorded timeline, got:\n%s", out)
+	}
+}
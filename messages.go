@@ -0,0 +1,216 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+// EventKind identifies a kind of record: the record logged for an fx event,
+// distinguishing successes from failures, such as KindOnStartExecuted and
+// KindOnStartFailed, or one of the summaries and warnings the Logger adds,
+// such as KindShutdownSummary.
+type EventKind string
+
+// The kinds of records logged for fx events.
+const (
+	KindOnStartExecuting  EventKind = "OnStartExecuting"
+	KindOnStartExecuted   EventKind = "OnStartExecuted"
+	KindOnStartFailed     EventKind = "OnStartFailed"
+	KindOnStopExecuting   EventKind = "OnStopExecuting"
+	KindOnStopExecuted    EventKind = "OnStopExecuted"
+	KindOnStopFailed      EventKind = "OnStopFailed"
+	KindSupplied          EventKind = "Supplied"
+	KindSupplyFailed      EventKind = "SupplyFailed"
+	KindProvided          EventKind = "Provided"
+	KindProvideFailed     EventKind = "ProvideFailed"
+	KindDecorated         EventKind = "Decorated"
+	KindDecorateFailed    EventKind = "DecorateFailed"
+	KindRun               EventKind = "Run"
+	KindRunFailed         EventKind = "RunFailed"
+	KindInvoking          EventKind = "Invoking"
+	KindInvokeFailed      EventKind = "InvokeFailed"
+	KindStopping          EventKind = "Stopping"
+	KindStopped           EventKind = "Stopped"
+	KindStopFailed        EventKind = "StopFailed"
+	KindRollingBack       EventKind = "RollingBack"
+	KindRolledBack        EventKind = "RolledBack"
+	KindRollbackFailed    EventKind = "RollbackFailed"
+	KindStarted           EventKind = "Started"
+	KindStartFailed       EventKind = "StartFailed"
+	KindLoggerInitialized EventKind = "LoggerInitialized"
+	KindLoggerInitFailed  EventKind = "LoggerInitFailed"
+)
+
+// The kinds of the summaries and warnings the Logger adds to the records of
+// fx events. The message of KindEventsSuppressed is a template, in which
+// {suppressed} is replaced by the number of events suppressed.
+const (
+	KindSlowestStartHooks  EventKind = "SlowestStartHooks"
+	KindSlowestStopHooks   EventKind = "SlowestStopHooks"
+	KindHookDistribution   EventKind = "HookDistribution"
+	KindModuleStartTiming  EventKind = "ModuleStartTiming"
+	KindModuleStopTiming   EventKind = "ModuleStopTiming"
+	KindStartupTimeline    EventKind = "StartupTimeline"
+	KindTraceWriteFailed   EventKind = "TraceWriteFailed"
+	KindConstructorSummary EventKind = "ConstructorSummary"
+	KindDependencyGraph    EventKind = "DependencyGraph"
+	KindGraphWriteFailed   EventKind = "GraphWriteFailed"
+	KindShutdownSummary    EventKind = "ShutdownSummary"
+	KindDuplicateProvider  EventKind = "DuplicateProvider"
+	KindOutOfOrder         EventKind = "OutOfOrder"
+	KindEventsSuppressed   EventKind = "EventsSuppressed"
+	KindConfigReloadFailed EventKind = "ConfigReloadFailed"
+	KindCloudEventFailed   EventKind = "CloudEventFailed"
+	KindRecordsDropped     EventKind = "RecordsDropped"
+)
+
+// defaultMessages are the messages of each kind of record.
+var defaultMessages = map[EventKind]string{
+	KindOnStartExecuting:  "OnStart hook executing",
+	KindOnStartExecuted:   "OnStart hook executed",
+	KindOnStartFailed:     "OnStart hook failed",
+	KindOnStopExecuting:   "OnStop hook executing",
+	KindOnStopExecuted:    "OnStop hook executed",
+	KindOnStopFailed:      "OnStop hook failed",
+	KindSupplied:          "supplied",
+	KindSupplyFailed:      "error encountered while applying options",
+	KindProvided:          "provided",
+	KindProvideFailed:     "error encountered while applying options",
+	KindDecorated:         "decorated",
+	KindDecorateFailed:    "error encountered while applying options",
+	KindRun:               "run",
+	KindRunFailed:         "error returned",
+	KindInvoking:          "invoking",
+	KindInvokeFailed:      "invoke failed",
+	KindStopping:          "received signal",
+	KindStopped:           "stopped",
+	KindStopFailed:        "stop failed",
+	KindRollingBack:       "start failed, rolling back",
+	KindRolledBack:        "rolled back",
+	KindRollbackFailed:    "rollback failed",
+	KindStarted:           "started",
+	KindStartFailed:       "start failed",
+	KindLoggerInitialized: "initialized custom fxevent.Logger",
+	KindLoggerInitFailed:  "custom logger initialization failed",
+
+	KindSlowestStartHooks:  "slowest OnStart hooks",
+	KindSlowestStopHooks:   "slowest OnStop hooks",
+	KindHookDistribution:   "hook runtime distribution",
+	KindModuleStartTiming:  "module start timing",
+	KindModuleStopTiming:   "module stop timing",
+	KindStartupTimeline:    "startup timeline",
+	KindTraceWriteFailed:   "failed to write startup trace",
+	KindConstructorSummary: "constructor summary",
+	KindDependencyGraph:    "dependency graph",
+	KindGraphWriteFailed:   "failed to write dependency graph",
+	KindShutdownSummary:    "shutdown summary",
+	KindDuplicateProvider:  "type provided by multiple constructors",
+	KindOutOfOrder:         "lifecycle event out of order",
+	KindEventsSuppressed:   "suppressed {suppressed} similar events",
+	KindConfigReloadFailed: "failed to reload config",
+	KindCloudEventFailed:   "failed to send CloudEvent",
+	KindRecordsDropped:     "log records dropped",
+}
+
+// normalizedMessages are the messages of WithNormalizedMessages: lower
+// case, naming the subject first, with "executing" while in progress, the
+// past tense once done and "failed" on failure.
+var normalizedMessages = map[EventKind]string{
+	KindOnStartExecuting:  "start hook executing",
+	KindOnStartExecuted:   "start hook executed",
+	KindOnStartFailed:     "start hook failed",
+	KindOnStopExecuting:   "stop hook executing",
+	KindOnStopExecuted:    "stop hook executed",
+	KindOnStopFailed:      "stop hook failed",
+	KindSupplied:          "value supplied",
+	KindSupplyFailed:      "supply failed",
+	KindProvided:          "type provided",
+	KindProvideFailed:     "provide failed",
+	KindDecorated:         "type decorated",
+	KindDecorateFailed:    "decorate failed",
+	KindRun:               "function executed",
+	KindRunFailed:         "function failed",
+	KindInvoking:          "invoke executing",
+	KindInvokeFailed:      "invoke failed",
+	KindStopping:          "signal received",
+	KindStopped:           "application stopped",
+	KindStopFailed:        "stop failed",
+	KindRollingBack:       "rollback executing",
+	KindRolledBack:        "rollback executed",
+	KindRollbackFailed:    "rollback failed",
+	KindStarted:           "application started",
+	KindStartFailed:       "start failed",
+	KindLoggerInitialized: "logger initialized",
+	KindLoggerInitFailed:  "logger initialization failed",
+
+	KindSlowestStartHooks:  "slowest start hooks",
+	KindSlowestStopHooks:   "slowest stop hooks",
+	KindHookDistribution:   "hook runtime distribution",
+	KindModuleStartTiming:  "module start timing",
+	KindModuleStopTiming:   "module stop timing",
+	KindStartupTimeline:    "startup timeline",
+	KindTraceWriteFailed:   "startup trace write failed",
+	KindConstructorSummary: "constructor summary",
+	KindDependencyGraph:    "dependency graph",
+	KindGraphWriteFailed:   "dependency graph write failed",
+	KindShutdownSummary:    "shutdown summary",
+	KindDuplicateProvider:  "type provided by multiple constructors",
+	KindOutOfOrder:         "lifecycle event out of order",
+	KindEventsSuppressed:   "{suppressed} similar events suppressed",
+	KindConfigReloadFailed: "config reload failed",
+	KindCloudEventFailed:   "cloudevent send failed",
+	KindRecordsDropped:     "log records dropped",
+}
+
+// MessageCatalog supplies the messages of kinds of records, for example in
+// another language. Message reports false for kinds it has no message for,
+// which keep their default English message. Field names are unaffected.
+type MessageCatalog interface {
+	Message(kind EventKind) (string, bool)
+}
+
+// MessageMap is a MessageCatalog holding the messages of kinds of records.
+type MessageMap map[EventKind]string
+
+// Message returns the message of kind, if m has one.
+func (m MessageMap) Message(kind EventKind) (string, bool) {
+	msg, ok := m[kind]
+	return msg, ok
+}
+
+// MessageCatalogFunc adapts a function to a MessageCatalog, such as a
+// lookup in a go-i18n bundle using the kind as the message ID:
+//
+//	fxeventzerolog.MessageCatalogFunc(func(kind fxeventzerolog.EventKind) (string, bool) {
+//		msg, err := localizer.Localize(&i18n.LocalizeConfig{MessageID: string(kind)})
+//		return msg, err == nil
+//	})
+type MessageCatalogFunc func(kind EventKind) (string, bool)
+
+// Message calls f.
+func (f MessageCatalogFunc) Message(kind EventKind) (string, bool) {
+	return f(kind)
+}
+
+// message returns the message of the kind of record, as overridden by
+// WithMessages or given by the WithMessageCatalog catalog.
+func (l *Logger) message(kind EventKind) string {
+	if msg, ok := l.messages[kind]; ok {
+		return msg
+	}
+	if l.catalog != nil {
+		if msg, ok := l.catalog.Message(kind); ok {
+			return msg
+		}
+	}
+	return defaultMessages[kind]
+}
+
+// Send completes the entry with the message of the kind of record, rendered
+// from its template if WithMessageTemplates gives one, and hands it to the
+// logger.
+func (e *entry) Send(kind EventKind) {
+	if t, ok := e.l.templates[kind]; ok {
+		e.MsgTemplate(t)
+		return
+	}
+	e.Msg(e.l.message(kind))
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithMessages(t *testing.T) {
+	logger, buf := newTestLoggerWith(
+		WithMessages(map[EventKind]string{KindProvided: "provided type", KindStartFailed: "start failed"}),
+		WithMessages(map[EventKind]string{KindStartFailed: "application failed to start"}),
+	)
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+
+	out := buf.String()
+	for _, want := range []string{`"message":"provided type"`, `"message":"invoking"`, `"message":"application failed to start"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestDefaultMessages(t *testing.T) {
+	kinds := []EventKind{
+		KindOnStartExecuting, KindOnStartExecuted, KindOnStartFailed,
+		KindOnStopExecuting, KindOnStopExecuted, KindOnStopFailed,
+		KindSupplied, KindSupplyFailed, KindProvided, KindProvideFailed,
+		KindDecorated, KindDecorateFailed, KindRun, KindRunFailed,
+		KindInvoking, KindInvokeFailed, KindStopping, KindStopped, KindStopFailed,
+		KindRollingBack, KindRolledBack, KindRollbackFailed, KindStarted, KindStartFailed,
+		KindLoggerInitialized, KindLoggerInitFailed,
+		KindSlowestStartHooks, KindSlowestStopHooks, KindHookDistribution,
+		KindModuleStartTiming, KindModuleStopTiming, KindStartupTimeline, KindTraceWriteFailed,
+		KindConstructorSummary, KindDependencyGraph, KindGraphWriteFailed, KindShutdownSummary,
+		KindDuplicateProvider, KindOutOfOrder, KindEventsSuppressed, KindConfigReloadFailed,
+		KindCloudEventFailed, KindRecordsDropped,
+	}
+	for _, kind := range kinds {
+		if len(defaultMessages[kind]) == 0 {
+			t.Errorf("Expected a default message for %s", kind)
+		}
+	}
+}
+
+func TestWithMessages_Summaries(t *testing.T) {
+	audit := &bytes.Buffer{}
+	al := zerolog.New(audit)
+	logger, buf := newTestLoggerWith(
+		WithSlowestStartHooks(1),
+		WithDuplicateProvideWarnings(),
+		WithRateLimit(0, 1),
+		WithAuditLogger(&al),
+		WithMessageCatalog(MessageMap{
+			KindSlowestStartHooks: "crochets les plus lents",
+			KindDuplicateProvider: "type fourni plusieurs fois",
+			KindEventsSuppressed:  "{suppressed} événements similaires supprimés",
+			KindStarted:           "démarré",
+		}),
+	)
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewA()", OutputTypeNames: []string{"*main.T"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewB()", OutputTypeNames: []string{"*main.T"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	for _, want := range []string{
+		`"message":"crochets les plus lents"`,
+		`"message":"type fourni plusieurs fois"`,
+		`"message":"2 événements similaires supprimés"`,
+		`"message":"démarré"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+	if !strings.Contains(audit.String(), `"message":"démarré"`) {
+		t.Errorf("Expected audit records to use the catalog, got %s", audit.String())
+	}
+}
+
+func TestWithMessageCatalog(t *testing.T) {
+	logger, buf := newTestLoggerWith(
+		WithMessageCatalog(MessageMap{KindStarted: "démarré", KindInvoking: "appel"}),
+		WithMessages(map[EventKind]string{KindInvoking: "invoking function"}),
+	)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+
+	out := buf.String()
+	for _, want := range []string{`"message":"invoking function"`, `"message":"démarré"`, `"message":"stop failed"`, `"function":"main.run()"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestMessageCatalogFunc(t *testing.T) {
+	catalog := MessageCatalogFunc(func(kind EventKind) (string, bool) {
+		if kind == KindProvided {
+			return "bereitgestellt", true
+		}
+		return "", false
+	})
+	logger, buf := newTestLoggerWith(WithMessageCatalog(catalog))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := buf.String()
+	for _, want := range []string{`"message":"bereitgestellt"`, `"message":"invoking"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestWithNormalizedMessages(t *testing.T) {
+	for kind := range defaultMessages {
+		msg, ok := normalizedMessages[kind]
+		if !ok {
+			t.Errorf("Expected a normalized message for %s", kind)
+			continue
+		}
+		if msg != strings.ToLower(msg) {
+			t.Errorf("Expected %q to be lower case", msg)
+		}
+		if strings.HasSuffix(string(kind), "Failed") != strings.HasSuffix(msg, "failed") {
+			t.Errorf("Expected %q to end in failed only for failures, kind %s", msg, kind)
+		}
+	}
+
+	logger, buf := newTestLoggerWith(
+		WithNormalizedMessages(),
+		WithMessages(map[EventKind]string{KindStarted: "ready"}),
+	)
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	for _, want := range []string{`"message":"start hook executed"`, `"message":"signal received"`, `"message":"ready"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"regexp"
+	"slices"
+)
+
+// packagePath matches the import path prefixes of qualified names, such as
+// "example.com/app/" in "example.com/app/server.New()".
+var packagePath = regexp.MustCompile(`[\w.~-]+/`)
+
+// shortName returns name without the import paths of its packages, e.g.
+// "server.(*Server).Start" for "example.com/app/server.(*Server).Start".
+func shortName(name string) string {
+	return packagePath.ReplaceAllLiteralString(name, "")
+}
+
+// shortenedKeys are the fields holding qualified names, which PresetProduction
+// shortens.
+var shortenedKeys = append([]string{"type"}, hashedKeys...)
+
+// minimizeFields drops the fields of an entry that are not scalar, such as
+// traces and summaries, and its stack field, and shortens the qualified names
+// of the rest.
+func minimizeFields(e *entry) {
+	e.fields = slices.DeleteFunc(e.fields, func(f field) bool {
+		switch f.kind {
+		case stringsField, objectField, arrayField:
+			return true
+		}
+		return f.key == "stack"
+	})
+	for i, f := range e.fields {
+		if f.kind == stringField && slices.Contains(shortenedKeys, f.key) {
+			e.fields[i].str = e.l.names.shortName(f.str)
+		}
+	}
+}
+
+// filterFields drops the fields of an entry that WithDeniedFields denies or,
+// if WithAllowedFields is given, that it does not allow.
+func (l *Logger) filterFields(e *entry) {
+	e.fields = slices.DeleteFunc(e.fields, func(f field) bool {
+		if _, ok := l.deniedFields[f.key]; ok {
+			return true
+		}
+		_, ok := l.allowedFields[f.key]
+		return l.allowedFields != nil && !ok
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestShortName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"example.com/app/server.(*Server).Start", "server.(*Server).Start"},
+		{"*example.com/app/db.DB", "*db.DB"},
+		{"[]example.com/app/db.Option", "[]db.Option"},
+		{"map[string]example.com/a/b.T", "map[string]b.T"},
+		{"main.run()", "main.run()"},
+	}
+	for _, tt := range tests {
+		if got := shortName(tt.name); got != tt.want {
+			t.Errorf("Expected %s to shorten to %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestPresetProduction(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetProduction(), WithSlowestStartHooks(2))
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "example.com/app/db.New()",
+		OutputTypeNames: []string{"*example.com/app/db.DB"},
+		ModuleName:      "db",
+		StackTrace:      []string{"example.com/app/db.New (/src/db/db.go:12)"},
+		ModuleTrace:     []string{"/src/main.go:10"},
+	})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "example.com/app/db.(*DB).Start", CallerName: "example.com/app/db.New()", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "example.com/app.run()", Err: errors.New("boom"), Trace: "/src/main.go:20"})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	for _, want := range []string{
+		`"constructor":"db.New()"`, `"type":"*db.DB"`, `"module":"db"`,
+		`"callee":"db.(*DB).Start","caller":"db.New()","runtime":"1ms"`,
+		`"function":"app.run()"`, `"error":"boom"`,
+		`{"level":"info","message":"slowest OnStart hooks"}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"example.com", ".go:", "stacktrace", "moduletrace"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected no %s, got %s", unwanted, out)
+		}
+	}
+}
+
+func TestPresetProduction_Templates(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetProduction(), WithMessageTemplates(map[EventKind]string{KindProvided: "{constructor} gives {type}"}))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/app/db.New()", OutputTypeNames: []string{"*example.com/app/db.DB"}})
+
+	if out := buf.String(); !strings.Contains(out, `"message":"db.New() gives *db.DB"`) {
+		t.Errorf("Expected the message rendered from the shortened names, got %s", out)
+	}
+}
+
+func TestPresetProduction_Redaction(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetProduction(), WithRedactedTypes(`^example\.com/internal/`))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/internal/db.New()", OutputTypeNames: []string{"*db.DB"}})
+	if out := buf.String(); !strings.Contains(out, `"constructor":"[redacted]"`) {
+		t.Errorf("Expected redaction to match the full name, got %s", out)
+	}
+}
+
+func TestWithAllowedFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithAllowedFields("callee", "runtime"), WithAllowedFields("error"))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}})
+
+	out := buf.String()
+	for _, want := range []string{
+		`{"level":"info","callee":"main.start()","runtime":"1ms","message":"OnStart hook executed"}`,
+		`{"level":"error","callee":"main.start()","error":"boom","message":"OnStart hook failed"}`,
+		`{"level":"info","message":"provided"}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestWithDeniedFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDeniedFields("caller", "stacktrace", "moduletrace"), WithAllowedFields("callee", "caller"))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}, StackTrace: []string{"main.go:1"}})
+
+	out := buf.String()
+	if strings.Contains(out, "caller") || strings.Contains(out, "trace") {
+		t.Errorf("Expected denied fields to be dropped, got %s", out)
+	}
+	if !strings.Contains(out, `"callee":"main.start()"`) {
+		t.Errorf("Expected allowed fields to be kept, got %s", out)
+	}
+}
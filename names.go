@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "sync"
+
+// maxCachedNames bounds the number of names of each kind a nameCache holds,
+// so that applications that generate names cannot grow it without limit.
+// Names beyond it are processed on every use.
+const maxCachedNames = 4096
+
+// nameCache memoizes the processing of the constructor, type, hook and module
+// trace names found in events, keyed by the original string: these repeat
+// constantly, so the cost is paid once per unique name rather than per event.
+// It has its own lock, so that callers need not hold the Logger's. A nil
+// nameCache processes every name.
+type nameCache struct {
+	mu      sync.Mutex
+	short   map[string]string     // shortName results
+	modules map[string]traceFrame // traceModule results
+}
+
+// traceFrame is a parsed module trace entry.
+type traceFrame struct {
+	module string
+	ok     bool // whether the entry names a module
+}
+
+// shortName returns shortName(name), cached.
+func (c *nameCache) shortName(name string) string {
+	if c == nil {
+		return shortName(name)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if s, ok := c.short[name]; ok {
+		return s
+	}
+	s := shortName(name)
+	if c.short == nil {
+		c.short = make(map[string]string)
+	}
+	if len(c.short) < maxCachedNames {
+		c.short[name] = s
+	}
+	return s
+}
+
+// traceModule returns traceModule(entry), cached.
+func (c *nameCache) traceModule(entry string) (string, bool) {
+	if c == nil {
+		return traceModule(entry)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if f, ok := c.modules[entry]; ok {
+		return f.module, f.ok
+	}
+	var f traceFrame
+	f.module, f.ok = traceModule(entry)
+	if c.modules == nil {
+		c.modules = make(map[string]traceFrame)
+	}
+	if len(c.modules) < maxCachedNames {
+		c.modules[entry] = f
+	}
+	return f.module, f.ok
+}
+
+// traceModules returns traceModules(trace), parsing each entry through the
+// cache.
+func (c *nameCache) traceModules(trace []string) []string {
+	var names []string
+	for _, t := range trace {
+		if name, ok := c.traceModule(t); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestNameCache(t *testing.T) {
+	var c nameCache
+	for range 2 {
+		if got := c.shortName("*example.com/app/db.DB"); got != "*db.DB" {
+			t.Errorf("Expected *db.DB, got %s", got)
+		}
+		if got, ok := c.traceModule("main.main (main.go:5) (inner)"); !ok || got != "inner" {
+			t.Errorf("Expected module inner, got %q, %v", got, ok)
+		}
+		if _, ok := c.traceModule("main.New (main.go:1)"); ok {
+			t.Errorf("Expected a constructor location not to be a module")
+		}
+	}
+	if len(c.short) != 1 || len(c.modules) != 2 {
+		t.Errorf("Expected each name to be cached once, got %d and %d", len(c.short), len(c.modules))
+	}
+
+	var nilCache *nameCache
+	if got := nilCache.traceModules([]string{"main.main (main.go:5) (inner)"}); len(got) != 1 || got[0] != "inner" {
+		t.Errorf("Expected a nil cache to parse traces, got %v", got)
+	}
+	if got := nilCache.shortName("example.com/app/server.New()"); got != "server.New()" {
+		t.Errorf("Expected a nil cache to shorten names, got %s", got)
+	}
+}
+
+func TestNameCache_Bounded(t *testing.T) {
+	var c nameCache
+	for i := range maxCachedNames + 10 {
+		entry := "main.main (main.go:5) (m" + strconv.Itoa(i) + ")"
+		if got, _ := c.traceModule(entry); got != "m"+strconv.Itoa(i) {
+			t.Fatalf("Expected m%d, got %s", i, got)
+		}
+	}
+	if len(c.modules) != maxCachedNames {
+		t.Errorf("Expected the cache to hold %d entries, got %d", maxCachedNames, len(c.modules))
+	}
+	for i := range maxCachedNames + 10 {
+		c.shortName("example.com/app/db.T" + strconv.Itoa(i))
+	}
+	if len(c.short) != maxCachedNames {
+		t.Errorf("Expected the cache to hold %d names, got %d", maxCachedNames, len(c.short))
+	}
+}
+
+func TestNameCache_Allocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not stable with the race detector")
+	}
+	trace := []string{"main.New (main.go:1)", "main.main (main.go:5) (inner)", "main.main (main.go:3) (outer)"}
+	if allocs := testing.AllocsPerRun(100, func() { traceModules(trace) }); allocs == 0 {
+		t.Fatalf("Expected parsing a trace into a slice to allocate")
+	}
+	logger := NewDiscard(WithModuleLevel("outer", zerolog.DebugLevel))
+	logger.moduleLevel("server", trace)
+	if allocs := testing.AllocsPerRun(100, func() { logger.moduleLevel("server", trace) }); allocs != 0 {
+		t.Errorf("Expected no allocations to find a module level, got %v", allocs)
+	}
+
+	const name = "example.com/app/server.(*Server).Start"
+	if allocs := testing.AllocsPerRun(100, func() { shortName(name) }); allocs == 0 {
+		t.Fatalf("Expected shortening a name to allocate")
+	}
+	logger = NewDiscard(PresetProduction())
+	event := &fxevent.OnStartExecuted{FunctionName: name, CallerName: "example.com/app/server.New"}
+	logger.LogEvent(event)
+	if allocs := testing.AllocsPerRun(100, func() { logger.LogEvent(event) }); allocs != 0 {
+		t.Errorf("Expected no allocations for a shortened hook, got %v", allocs)
+	}
+}
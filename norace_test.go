@@ -0,0 +1,9 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+//go:build !race
+
+package fxeventzerolog
+
+// raceEnabled reports whether the race detector is enabled.
+const raceEnabled = false
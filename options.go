@@ -0,0 +1,788 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"cmp"
+	"io"
+	"log/slog"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/amari/fxevent-zerolog/internal/term"
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+	"golang.org/x/time/rate"
+)
+
+// Option configures a Logger created by New.
+type Option func(*Logger)
+
+// WithLogLevel sets the level of non-error records (default: zerolog.InfoLevel).
+func WithLogLevel(lvl zerolog.Level) Option {
+	return func(l *Logger) {
+		l.logLvl = lvl
+	}
+}
+
+// WithErrorLevel sets the level of error records (default: zerolog.ErrorLevel).
+func WithErrorLevel(lvl zerolog.Level) Option {
+	return func(l *Logger) {
+		l.errorLvl = lvl
+	}
+}
+
+// WithoutStackTraces omits the stacktrace, moduletrace and stack fields.
+func WithoutStackTraces() Option {
+	return func(l *Logger) {
+		l.noTraces = true
+	}
+}
+
+// WithTracesOnError only adds the stacktrace and moduletrace fields to the
+// records of events carrying an error, where they help diagnose the failure,
+// sparing successful Provided, Supplied and Decorated records the cost of
+// encoding them.
+func WithTracesOnError() Option {
+	return func(l *Logger) {
+		l.errorTraces = true
+	}
+}
+
+// WithMessages overrides the messages of kinds of records, for example to
+// match the messages of a previous logger that alerts are keyed on:
+//
+//	fxeventzerolog.WithMessages(map[fxeventzerolog.EventKind]string{
+//		fxeventzerolog.KindProvided:    "provided type",
+//		fxeventzerolog.KindStartFailed: "application failed to start",
+//	})
+//
+// Kinds not in messages keep their default message. Options given later
+// override earlier ones kind by kind.
+func WithMessages(messages map[EventKind]string) Option {
+	return func(l *Logger) {
+		if l.messages == nil {
+			l.messages = make(map[EventKind]string, len(messages))
+		}
+		maps.Copy(l.messages, messages)
+	}
+}
+
+// WithNormalizedMessages replaces the default messages, whose styles
+// differ ("OnStart hook executing", "provided", "received signal"), with
+// messages in a single style that dashboards can group by: lower case, the
+// subject first, "executing" while in progress, the past tense once done
+// and "failed" on failure, such as "start hook executing", "start hook
+// executed", "type provided" and "signal received". It is the same as
+// WithMessages with these messages, so options given later override it kind
+// by kind.
+func WithNormalizedMessages() Option {
+	return WithMessages(normalizedMessages)
+}
+
+// WithMessageCatalog takes the messages of records from catalog, for
+// example to log them in another language or with an organization's own
+// terminology, leaving the structured fields as they are:
+//
+//	fxeventzerolog.WithMessageCatalog(fxeventzerolog.MessageMap{
+//		fxeventzerolog.KindStarted: "démarré",
+//		fxeventzerolog.KindStopped: "arrêté",
+//	})
+//
+// Messages set by WithMessages take precedence over the catalog, and kinds
+// the catalog has no message for keep their default message.
+func WithMessageCatalog(catalog MessageCatalog) Option {
+	return func(l *Logger) {
+		l.catalog = catalog
+	}
+}
+
+// WithMessageTemplates renders the messages of kinds of records from
+// templates referring to their fields in braces, such as
+// "{callee} started in {runtime}", for readers of the message. The fields
+// are still written as well, and the message shows them as they are
+// written: redacted, hashed or shortened. Templates take precedence over
+// WithMessages; references to fields a record lacks are kept as they are.
+func WithMessageTemplates(templates map[EventKind]string) Option {
+	return func(l *Logger) {
+		if l.templates == nil {
+			l.templates = make(map[EventKind]messageTemplate, len(templates))
+		}
+		for kind, tmpl := range templates {
+			l.templates[kind] = parseTemplate(tmpl)
+		}
+	}
+}
+
+// WithFieldLimits caps the size of the fields written: string arrays such as
+// stack traces keep at most maxElements elements, followed by a
+// "...(N more)" marker, and strings such as long generic type names keep at
+// most maxLength bytes, followed by a "...(N bytes truncated)" marker. A
+// limit of 0 or less disables it.
+func WithFieldLimits(maxElements, maxLength int) Option {
+	return func(l *Logger) {
+		if maxElements <= 0 && maxLength <= 0 {
+			l.limits = nil
+			return
+		}
+		l.limits = &fieldLimits{elements: maxElements, length: maxLength}
+	}
+}
+
+// WithoutFxInternals suppresses Provided records for the constructors fx
+// registers itself (fx.Lifecycle, fx.Shutdowner, fx.DotGraph), which are
+// present in every application. Provide errors are still logged.
+func WithoutFxInternals() Option {
+	return func(l *Logger) {
+		l.skipFxInternals = true
+	}
+}
+
+// WithDecorations logs Decorated events, which are not logged by default: a
+// "decorated" record for each type a decorator outputs, and an error record
+// if applying it failed.
+func WithDecorations() Option {
+	return func(l *Logger) {
+		l.decorations = true
+	}
+}
+
+// WithSuppressedTypes drops Provided, Supplied and Decorated records whose
+// type name or constructor/decorator name matches any of the given regular
+// expressions. Errors are always logged. It panics if a pattern does not
+// compile.
+func WithSuppressedTypes(patterns ...string) Option {
+	res := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		res[i] = regexp.MustCompile(p)
+	}
+	return func(l *Logger) {
+		l.suppressed = append(l.suppressed, res...)
+	}
+}
+
+// WithRedactedTypes replaces the type, constructor, decorator, function and
+// hook names of every record that match any of the given regular
+// expressions with "[redacted]", for example to keep internal package paths
+// out of a third-party log service:
+//
+//	fxeventzerolog.WithRedactedTypes(`^example\.com/internal/`)
+//
+// Matching stack and module trace frames, lines of Invoked stacks and nodes
+// of the WithGraphLog and WithGraphFile graphs are redacted too. Names are
+// matched before WithFieldLimits truncates them. Records are still logged,
+// and suppression by WithSuppressedTypes still matches the original names.
+// It panics if a pattern does not compile.
+func WithRedactedTypes(patterns ...string) Option {
+	res := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		res[i] = regexp.MustCompile(p)
+	}
+	return func(l *Logger) {
+		l.redacted = append(l.redacted, res...)
+	}
+}
+
+// WithHashedNames replaces the function, constructor, decorator and hook
+// caller names of records with short stable hashes, for regulated
+// environments where code structure must not reach external log storage but
+// records still need to be correlated. The same name always has the same
+// hash for a given salt, which keeps the hashes of well-known names from
+// being looked up; NameTable and WriteNameTable return the mapping back to
+// the names. Names are hashed before WithFieldLimits truncates them, the
+// WithGraphLog and WithGraphFile graphs use the hashes, and stack and module
+// traces, which also reveal code structure, are dropped.
+func WithHashedNames(salt string) Option {
+	return func(l *Logger) {
+		l.hasher = &nameHasher{salt: salt, hashes: make(map[string]string)}
+	}
+}
+
+// WithAllowedFields restricts the fields of every record to the given keys,
+// whichever event produced it, to enforce exactly which fields leave the
+// process. The keys are those written, after any backend preset has renamed
+// them; the level, message and timestamp added by zerolog are always kept.
+// Several calls allow the union of their keys.
+func WithAllowedFields(keys ...string) Option {
+	return func(l *Logger) {
+		if l.allowedFields == nil {
+			l.allowedFields = make(map[string]struct{}, len(keys))
+		}
+		for _, key := range keys {
+			l.allowedFields[key] = struct{}{}
+		}
+	}
+}
+
+// WithDeniedFields drops the fields with the given keys from every record,
+// whichever event produced it. Denied keys are dropped even if
+// WithAllowedFields allows them.
+func WithDeniedFields(keys ...string) Option {
+	return func(l *Logger) {
+		if l.deniedFields == nil {
+			l.deniedFields = make(map[string]struct{}, len(keys))
+		}
+		for _, key := range keys {
+			l.deniedFields[key] = struct{}{}
+		}
+	}
+}
+
+// WithDeduplication collapses identical consecutive records (same message,
+// level and fields, ignoring runtime) into a single record carrying a
+// repeat_count field. A record is held back until a different one arrives or
+// a lifecycle milestone (Started, Stopped, RolledBack) is logged.
+func WithDeduplication() Option {
+	return func(l *Logger) {
+		l.dedup = true
+	}
+}
+
+// WithRateLimit limits each event type to perEventType events per second with
+// the given burst, so a crash-looping hook cannot flood the log sink. Dropped
+// events are summarized in a "suppressed N similar events" record once the
+// type is allowed again, or at the next lifecycle milestone.
+func WithRateLimit(perEventType rate.Limit, burst int) Option {
+	return func(l *Logger) {
+		l.limiter = &rateLimiter{
+			limit:    perEventType,
+			burst:    burst,
+			limiters: make(map[string]*rate.Limiter),
+			dropped:  make(map[string]int),
+		}
+	}
+}
+
+// WithGraphSampler samples successful Provided and Run events, which are by
+// far the most numerous in large applications, using the given zerolog
+// sampler. Errors and lifecycle events are never sampled.
+func WithGraphSampler(s zerolog.Sampler) Option {
+	return func(l *Logger) {
+		l.graphSampler = s
+	}
+}
+
+// WithGraphSampling is like WithGraphSampler, logging the first successful
+// Provided and Run events, then every thereafter-th one. A thereafter of zero
+// drops all events past the first.
+func WithGraphSampling(first, thereafter int) Option {
+	return WithGraphSampler(&firstThenEvery{first: uint64(max(first, 0)), thereafter: uint64(max(thereafter, 0))})
+}
+
+// WithQuietStartup swallows individual Provided, Supplied, Decorated, Run,
+// Invoking and hook records, and instead attaches a summary (constructors,
+// modules, hooks and total duration) to the Started record. Errors are always
+// logged. This suits CLIs built on fx that shouldn't spam their output.
+func WithQuietStartup() Option {
+	return func(l *Logger) {
+		l.quiet = true
+		if l.startup == nil {
+			l.startup = &startupSummary{}
+		}
+	}
+}
+
+// WithDemoteAfterStart logs Provided, Supplied, Decorated, Run and Invoking
+// records at debug level once Started has been logged, since graph activity
+// after startup (lazily built values, late invokes) is rarely useful at info.
+func WithDemoteAfterStart() Option {
+	return func(l *Logger) {
+		l.demote = true
+	}
+}
+
+// WithExecutedHooksOnly skips OnStartExecuting and OnStopExecuting records,
+// relying on the Executed records that follow them, which carry the runtime
+// and any error.
+func WithExecutedHooksOnly() Option {
+	return func(l *Logger) {
+		l.executedOnly = true
+	}
+}
+
+// WithMinHookRuntime only logs successful OnStartExecuted, OnStopExecuted and
+// Run records whose runtime exceeds d. Failures are always logged.
+func WithMinHookRuntime(d time.Duration) Option {
+	return func(l *Logger) {
+		l.minRuntime = d
+	}
+}
+
+// WithModuleLevel logs the successful Provided, Supplied, Decorated, Run and
+// Invoking records of the named fx module, and of modules nested within it,
+// at lvl instead of the default log level. Errors keep the error level.
+func WithModuleLevel(module string, lvl zerolog.Level) Option {
+	return func(l *Logger) {
+		if l.moduleLevels == nil {
+			l.moduleLevels = make(map[string]zerolog.Level)
+		}
+		l.moduleLevels[module] = lvl
+	}
+}
+
+// WithConstructorSummary logs a "constructor summary" record just before the
+// Started record, counting the values provided, supplied and decorated during
+// startup overall and per module.
+func WithConstructorSummary() Option {
+	return func(l *Logger) {
+		l.graphSummary = true
+		if l.startup == nil {
+			l.startup = &startupSummary{}
+		}
+	}
+}
+
+// WithSlowestStartHooks logs a "slowest OnStart hooks" record just before the
+// Started record, listing the n slowest OnStart hooks with their runtimes.
+func WithSlowestStartHooks(n int) Option {
+	return func(l *Logger) {
+		l.slowStart = &slowestHooks{n: n}
+	}
+}
+
+// WithSlowestStopHooks logs a "slowest OnStop hooks" record when the
+// application has stopped, listing the n slowest OnStop hooks with their
+// runtimes.
+func WithSlowestStopHooks(n int) Option {
+	return func(l *Logger) {
+		l.slowStop = &slowestHooks{n: n}
+	}
+}
+
+// WithGraph accumulates the dependency graph wired by fx, so it can be
+// retrieved with Logger.GraphDOT, GraphJSON or GraphMermaid.
+func WithGraph() Option {
+	return func(l *Logger) {
+		if l.deps == nil {
+			l.deps = newDepGraph()
+			l.deps.names = &l.names
+		}
+	}
+}
+
+// WithGraphLog accumulates the dependency graph and logs it in DOT format as
+// the dot field of a "dependency graph" record when the application starts.
+func WithGraphLog() Option {
+	return func(l *Logger) {
+		WithGraph()(l)
+		l.graphLog = true
+	}
+}
+
+// WithGraphFile accumulates the dependency graph and writes it in DOT format
+// to the file at path when the application starts.
+func WithGraphFile(path string) Option {
+	return func(l *Logger) {
+		WithGraph()(l)
+		l.graphFile = path
+	}
+}
+
+// WithDuplicateProvideWarnings logs a warning when a type is provided by more
+// than one constructor, for example in different modules, which usually
+// indicates an accidental override. Decorations are not considered.
+func WithDuplicateProvideWarnings() Option {
+	return func(l *Logger) {
+		l.providers = make(map[string]string)
+	}
+}
+
+// WithModuleTimings aggregates constructor and hook runtimes per fx module and
+// logs a breakdown at Started ("module start timing") and Stopped ("module
+// stop timing"). Hooks are attributed to the module of the constructor or
+// invoked function that appended them.
+func WithModuleTimings() Option {
+	return func(l *Logger) {
+		l.timings = newModuleTimings()
+	}
+}
+
+// WithStartupTimeline logs a "startup timeline" record at Started listing
+// every OnStart hook in execution order, with its offset from the first event
+// and its runtime.
+func WithStartupTimeline() Option {
+	return func(l *Logger) {
+		if l.timeline == nil {
+			l.timeline = newStartupTimeline()
+		}
+		l.timelineLog = true
+	}
+}
+
+// WithStartupTraceFile writes the startup timeline to the file at path in the
+// Chrome trace event format when the application starts, for viewing in
+// chrome://tracing or Perfetto.
+func WithStartupTraceFile(path string) Option {
+	return func(l *Logger) {
+		if l.timeline == nil {
+			l.timeline = newStartupTimeline()
+		}
+		l.traceFile = path
+	}
+}
+
+// WithSlowRunThreshold logs successful Run records of constructors and
+// decorators that took longer than d at warn level with a slow=true field, so
+// heavyweight constructors stand out.
+func WithSlowRunThreshold(d time.Duration) Option {
+	return func(l *Logger) {
+		l.slowRun = d
+	}
+}
+
+// WithHookRuntimeDistribution logs a "hook runtime distribution" record when
+// the application has stopped, summarizing the runtimes of all OnStart and
+// OnStop hooks: count, min, p50, p95, max and counts per bucket.
+func WithHookRuntimeDistribution() Option {
+	return func(l *Logger) {
+		l.hookDist = &runtimeDistribution{}
+	}
+}
+
+// WithResourceSnapshot attaches the number of goroutines, in-use heap bytes
+// and GC count to the Started and Stopped records, so resource footprints can
+// be compared between releases. A "stopped" record is logged on successful
+// shutdown for this purpose.
+func WithResourceSnapshot() Option {
+	return func(l *Logger) {
+		l.resources = true
+	}
+}
+
+// WithDebugState records the lifecycle state rendered by DebugHandler: the
+// phase, the startup timeline, the slowest hooks and the recentErrors most
+// recent errors.
+func WithDebugState(recentErrors int) Option {
+	return func(l *Logger) {
+		l.debug = newDebugState(recentErrors)
+	}
+}
+
+// WithOrderValidation tracks the lifecycle with a small state machine and
+// logs a "lifecycle event out of order" warning when events arrive in an order
+// fx itself never produces, such as OnStopExecuted without OnStopExecuting or
+// a successful Started after RollingBack. This helps catch bugs in custom
+// Lifecycle implementations.
+func WithOrderValidation() Option {
+	return func(l *Logger) {
+		l.order = newOrderValidator()
+	}
+}
+
+// WithShutdownSummary logs a "shutdown summary" record after Stopped or
+// RolledBack with the number of OnStop hooks run and failed, the total
+// shutdown time and whether the shutdown was a rollback. It is logged at the
+// error level if any hook failed.
+func WithShutdownSummary() Option {
+	return func(l *Logger) {
+		l.shutdown = &shutdownSummary{}
+	}
+}
+
+// WithShutdownCause stamps a shutdown_cause field on the Stopping, Stopped
+// and RolledBack records: "signal" if a signal was received, "start_failure"
+// if a failed start is being rolled back, or "programmatic" if the
+// application was stopped directly. Successful Stopped and RolledBack events
+// are logged as "stopped" and "rolled back" records to carry it, so expected
+// restarts can be filtered from alerts.
+func WithShutdownCause() Option {
+	return func(l *Logger) {
+		l.causes = true
+	}
+}
+
+// WithTeeSink also hands every record to sink, in addition to the zerolog
+// logger or the sink of NewWithSink, for bridges to other logging APIs such
+// as fxotel.WithLogs. If sink is a LeveledSink, its Enabled method decides
+// what it receives, independently of the zerolog logger's level; otherwise
+// it receives every record. Options that drop records, such as
+// WithSuppressedTypes and sampling, apply to every output.
+func WithTeeSink(sink EventSink) Option {
+	return func(l *Logger) {
+		l.tees = append(l.tees, sink)
+	}
+}
+
+// WithSlog also forwards every record to logger with the same message and
+// attributes, for codebases migrating between zerolog and log/slog that keep
+// one fxevent wiring: levels map to their slog equivalents, durations stay
+// durations and nested objects become groups. logger's own level decides
+// what it receives, independently of the zerolog logger's, as for
+// WithTeeSink; records dropped by options such as WithSuppressedTypes or
+// sampling reach no output.
+func WithSlog(logger *slog.Logger) Option {
+	return func(l *Logger) {
+		l.slog = logger
+	}
+}
+
+// WithECSFields maps records to Elastic Common Schema conventions: the fx
+// event type becomes event.action, hook and constructor runtimes become
+// event.duration in nanoseconds, errors become error.message and
+// error.stack_trace, and log.logger is set to "fx". This lets lifecycle logs
+// land correctly typed in Elastic without ingest pipelines.
+func WithECSFields() Option {
+	return func(l *Logger) {
+		l.schema = ecsSchema
+	}
+}
+
+// WithSyslogSeverities sets the level of each record by event class, so that
+// zerolog's syslog writer sends it with a matching severity: failures to
+// start or roll back at crit (zerolog's panic level, which does not panic),
+// other failures at err, warnings at warning, lifecycle records at info and
+// dependency graph records at debug. Use NewSyslog to wire it up.
+func WithSyslogSeverities() Option {
+	return func(l *Logger) {
+		l.syslog = true
+	}
+}
+
+// WithJournaldFields adds a PRIORITY field derived from each record's level,
+// and a SYSLOG_IDENTIFIER field if identifier is not empty, for systemd-journald
+// JSON ingestion, so lifecycle errors are highlighted by journalctl and can be
+// filtered with journalctl -p.
+func WithJournaldFields(identifier string) Option {
+	return func(l *Logger) {
+		l.journald = true
+		l.identifier = identifier
+	}
+}
+
+// WithFailureHandler calls h after RollingBack, or Started or Stopped with an
+// error, has been logged, with the event and its error. Handlers can page,
+// POST a webhook or flip a readiness flag without scraping logs. They are
+// called synchronously, outside the Logger's lock; multiple handlers are
+// called in the order they were added.
+func WithFailureHandler(h func(event fxevent.Event, err error)) Option {
+	return func(l *Logger) {
+		l.onFailure = append(l.onFailure, h)
+	}
+}
+
+// WithLokiFields keeps only scalar, low-cardinality keys at the top level of
+// each record — module, kind, signal, shutdown_cause, private and slow, plus
+// the fx event type as event and its lifecycle phase as phase — so they can
+// be used as Loki labels. All other fields, such as callee, type, runtime,
+// errors and traces, are folded into a single logfmt-style details field.
+func WithLokiFields() Option {
+	return func(l *Logger) {
+		l.schema = lokiSchema
+	}
+}
+
+// WithTraceContextFunc stamps trace_id and span_id fields on every record,
+// calling fn for the IDs of each, so startup and shutdown logs join the trace
+// of the deployment that triggered them. Empty IDs are omitted.
+// PresetDatadog maps them to dd.trace_id and dd.span_id. fxotel's
+// WithTraceContext reads them from an OpenTelemetry span.
+func WithTraceContextFunc(fn func() (traceID, spanID string)) Option {
+	return func(l *Logger) {
+		l.traceIDs = fn
+	}
+}
+
+// WithAuditLogger also writes the lifecycle milestones — Started, Stopping,
+// Stopped, RollingBack and LoggerInitialized — to logger as an audit trail,
+// separate from the dependency graph records. Audit records are not subject
+// to the other options, except that their messages are those of WithMessages
+// or WithMessageCatalog; their level is controlled by logger alone.
+func WithAuditLogger(logger *zerolog.Logger) Option {
+	return func(l *Logger) {
+		l.audit = logger
+	}
+}
+
+// WithAuditChain adds a rolling hash to each record of the WithAuditLogger
+// audit trail under AuditHashKey, computed from the previous record's hash
+// and the whole record — its time, level, message and every other field,
+// including the audit logger's context fields — so that VerifyAuditChain
+// detects records that were removed or modified. Chained records carry
+// their own time, so the audit logger should not add one, nor use hooks
+// that add fields which differ from one call to the next. With a non-nil key the
+// hash is an HMAC-SHA256, which cannot be recomputed after tampering
+// without the key; with a nil key it is a plain SHA-256.
+func WithAuditChain(key []byte) Option {
+	return func(l *Logger) {
+		l.chain = &auditChain{key: key}
+	}
+}
+
+// WithCloudEvents publishes the lifecycle milestones — Started, RollingBack,
+// Stopping and Stopped — as CloudEvents from source through sender, so
+// platform automation can react to lifecycle transitions without scraping
+// logs. Failures have their own types, such as CloudEventStartFailed, with
+// the error in the event data. Events are sent once the milestone is
+// logged, outside the Logger's lock; a failure to send one is logged as a
+// KindCloudEventFailed warning.
+func WithCloudEvents(sender CloudEventSender, source string) Option {
+	return func(l *Logger) {
+		l.cloud = newCloudEmitter(sender, source)
+	}
+}
+
+// WithTransform calls fn on each event before it is handled, and handles the
+// event fn returns instead, so callers can rewrite function names, scrub
+// module names or replace events altogether. Returning nil drops the event.
+// Events are shared with fx and other loggers, so fn should modify a copy.
+// Multiple transforms are applied in the order they were added.
+func WithTransform(fn func(fxevent.Event) fxevent.Event) Option {
+	return func(l *Logger) {
+		l.transforms = append(l.transforms, fn)
+	}
+}
+
+// WithOnLogged calls fn after each record is written, with the event it was
+// written for and its level, for side effects such as bumping counters or
+// tracking the last error. An event may produce several records, or none.
+// fn is called outside the Logger's lock, once the event has been handled.
+func WithOnLogged(fn func(event fxevent.Event, level zerolog.Level)) Option {
+	return func(l *Logger) {
+		l.onLogged = append(l.onLogged, fn)
+	}
+}
+
+// OverrideHandler replaces how events of the same type as event are logged,
+// keeping the default handling of every other type. fn is given a zerolog
+// event at the configured log level, or error level if the event carries an
+// error, and must finish it with Msg or Send, or Discard it to log nothing:
+//
+//	fxeventzerolog.OverrideHandler(&fxevent.Provided{}, func(ev *zerolog.Event, event fxevent.Event) {
+//		e := event.(*fxevent.Provided)
+//		ev.Str("constructor", e.ConstructorName).Msg("provided")
+//	})
+//
+// Overridden events are still observed by summaries, filtered and sampled.
+// Their records are read back from the zerolog event and written like any
+// other, so they are redacted, hashed, filtered and mapped by schemas, and
+// reach the sink, routes and bridges, but they are not deduplicated. Errors
+// become error fields, and arrays of values other than strings and objects
+// are written as arrays of strings.
+func OverrideHandler(event fxevent.Event, fn func(*zerolog.Event, fxevent.Event)) Option {
+	t := reflect.TypeOf(event)
+	return func(l *Logger) {
+		if l.overrides == nil {
+			l.overrides = make(map[reflect.Type]func(*zerolog.Event, fxevent.Event))
+		}
+		l.overrides[t] = fn
+	}
+}
+
+// WithEventChannel sends every event to ch, in addition to logging it, so
+// other goroutines such as TUIs, dashboards or test harnesses can follow the
+// lifecycle live. Sends never block: events that do not fit in ch are
+// dropped and counted in Stats.ChannelDrops, so ch should be buffered. The
+// Logger never closes ch.
+func WithEventChannel(ch chan<- fxevent.Event) Option {
+	return func(l *Logger) {
+		l.events = ch
+	}
+}
+
+// WithEventStream streams every record to the clients of StreamHandler as
+// it is written, replaying the backlog most recent records to clients that
+// connect later, so that a client attached mid-startup still sees it from
+// the beginning.
+func WithEventStream(backlog int) Option {
+	return func(l *Logger) {
+		l.stream = newEventStream(backlog)
+	}
+}
+
+// WithAsync writes records on a worker goroutine, so slow writers such as
+// network sinks don't add their latency to fx hook execution. Up to size
+// records are queued; when the queue is full the oldest record is dropped
+// and counted in Stats.AsyncDrops. Call Flush or Close to wait for queued
+// records, for example after the application stops.
+func WithAsync(size int) Option {
+	return func(l *Logger) {
+		l.async = newAsyncWriter(size)
+	}
+}
+
+// WithConsole also writes every record to w in zerolog's human-readable
+// console format when w is a terminal, such as os.Stderr in a developer's
+// shell, while the zerolog logger passed to New keeps receiving JSON. When w
+// is not a terminal, for example in production, it has no effect. The
+// console uses the level of the zerolog logger passed to New. It has no
+// effect on a Logger created by NewConsole.
+func WithConsole(w io.Writer) Option {
+	return func(l *Logger) {
+		if term.IsTerminal(w) {
+			l.console = consoleLogger(w, l.inner.GetLevel())
+		}
+	}
+}
+
+// WithConsoleEmoji prefixes the console messages of lifecycle milestones
+// with emoji: 🚀 started, 🛑 stopped and 💥 for failed starts and
+// rollbacks. It only affects the output of NewConsole and WithConsole.
+func WithConsoleEmoji() Option {
+	return func(l *Logger) {
+		l.emoji = true
+	}
+}
+
+// WithConsoleDurations formats the durations of console records, such as
+// hook runtimes, with format, for example HumanDuration or
+// time.Duration.String for the exact value. NewConsole rounds them to three
+// significant digits by default. JSON records keep the full duration.
+func WithConsoleDurations(format func(time.Duration) string) Option {
+	return func(l *Logger) {
+		l.consoleDur = format
+	}
+}
+
+// WithLevelWriter writes records at min level or above to w instead of the
+// zerolog logger's own writer, keeping its context fields and level, for
+// example WithLevelWriter(zerolog.WarnLevel, os.Stderr) to send warnings and
+// errors to stderr and everything else to stdout. When several are given, a
+// record goes to the writer with the highest minimum level it reaches.
+func WithLevelWriter(min zerolog.Level, w io.Writer) Option {
+	return func(l *Logger) {
+		logger := l.inner.Output(w)
+		route := levelRoute{min: min, w: w, logger: &logger}
+		i, _ := slices.BinarySearchFunc(l.routes, route, func(a, b levelRoute) int {
+			return cmp.Compare(b.min, a.min)
+		})
+		l.routes = slices.Insert(l.routes, i, route)
+	}
+}
+
+// WithPostStartLogger switches to logger once the application has started
+// successfully, for example from verbose console output during boot to terse
+// JSON afterwards, without the application coordinating the swap. The
+// records logged for Started itself still go to the original logger.
+func WithPostStartLogger(logger *zerolog.Logger) Option {
+	return func(l *Logger) {
+		l.postStart = func(*zerolog.Logger) *zerolog.Logger {
+			return logger
+		}
+	}
+}
+
+// WithPostStartLevel is like WithPostStartLogger, but keeps the zerolog
+// logger and only changes its level to lvl once the application has started.
+func WithPostStartLevel(lvl zerolog.Level) Option {
+	return func(l *Logger) {
+		l.postStart = func(current *zerolog.Logger) *zerolog.Logger {
+			logger := current.Level(lvl)
+			return &logger
+		}
+	}
+}
+
+// WithClock makes the Logger read the current time from c instead of the
+// system clock, so time-derived fields can be tested deterministically, for
+// example with fxeventtest.FakeClock.
+func WithClock(c Clock) Option {
+	return func(l *Logger) {
+		if c != nil {
+			l.clock = c
+		}
+	}
+}
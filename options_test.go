@@ -0,0 +1,265 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func newTestLoggerWith(opts ...Option) (*Logger, *bytes.Buffer) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	return New(&zl, opts...).(*Logger), buf
+}
+
+func TestWithoutFxInternals(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithoutFxInternals())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.(*App).shutdowner-fm()", OutputTypeNames: []string{"fx.Shutdowner"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}})
+	out := buf.String()
+	if strings.Contains(out, "fx.Lifecycle") || strings.Contains(out, "fx.Shutdowner") {
+		t.Errorf("Expected fx internal types to be suppressed, got %s", out)
+	}
+	if !strings.Contains(out, "*main.Server") {
+		t.Error("Expected application types to be logged")
+	}
+}
+
+func TestWithoutFxInternals_KeepsErrors(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithoutFxInternals())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), "boom") {
+		t.Error("Expected provide error to be logged")
+	}
+}
+
+func TestWithoutFxInternals_DefaultOff(t *testing.T) {
+	logger, buf := newTestLogger()
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}})
+	if !strings.Contains(buf.String(), "fx.Lifecycle") {
+		t.Error("Expected fx internal types to be logged by default")
+	}
+}
+
+func TestWithDecorations(t *testing.T) {
+	decorated := &fxevent.Decorated{DecoratorName: "main.Wrap()", OutputTypeNames: []string{"*main.Server"}, Err: errors.New("boom")}
+	logger, buf := newTestLogger()
+	logger.LogEvent(decorated)
+	if buf.Len() > 0 {
+		t.Errorf("Expected Decorated events not to be logged by default, got %s", buf.String())
+	}
+
+	logger, buf = newTestLoggerWith(WithDecorations())
+	logger.LogEvent(decorated)
+	out := buf.String()
+	if !strings.Contains(out, `"type":"*main.Server","message":"decorated"`) {
+		t.Errorf("Expected a decorated record, got %s", out)
+	}
+	if !strings.Contains(out, `"error":"boom","message":"error encountered while applying options"`) {
+		t.Errorf("Expected the decorate failure, got %s", out)
+	}
+}
+
+func TestWithSuppressedTypes(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSuppressedTypes(`^gen\.`, `Noisy`), WithDecorations())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "gen.NewWire()", OutputTypeNames: []string{"*gen.Wire"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*vendor.NoisyClient", "*main.Server"}})
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*vendor.NoisyConfig"})
+	logger.LogEvent(&fxevent.Decorated{DecoratorName: "gen.Decorate()", OutputTypeNames: []string{"*main.Server"}})
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*main.Config"})
+	out := buf.String()
+	for _, unwanted := range []string{"gen.", "Noisy"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected %q to be suppressed, got %s", unwanted, out)
+		}
+	}
+	for _, want := range []string{"*main.Server", "*main.Config"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q to be logged", want)
+		}
+	}
+}
+
+func TestWithSuppressedTypes_KeepsErrors(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSuppressedTypes(`.*`))
+	logger.LogEvent(&fxevent.Supplied{TypeName: "T", Err: errors.New("supply failed")})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "ctor", Err: errors.New("provide failed")})
+	out := buf.String()
+	if !strings.Contains(out, "supply failed") || !strings.Contains(out, "provide failed") {
+		t.Errorf("Expected errors to be logged, got %s", out)
+	}
+}
+
+func TestWithSuppressedTypes_InvalidPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid pattern")
+		}
+	}()
+	WithSuppressedTypes("(")
+}
+
+func TestWithDeduplication(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDeduplication())
+	for i := range 3 {
+		logger.LogEvent(&fxevent.Run{Name: "ctor", Kind: "provide", Runtime: time.Duration(i)})
+	}
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "fn"})
+	logger.LogEvent(&fxevent.Started{})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 records, got %d: %s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], `"repeat_count":3`) {
+		t.Errorf("Expected repeat_count on collapsed record, got %s", lines[0])
+	}
+	if strings.Contains(lines[1], "repeat_count") {
+		t.Errorf("Expected no repeat_count on single record, got %s", lines[1])
+	}
+	if !strings.Contains(lines[2], "started") {
+		t.Errorf("Expected started record to be flushed, got %s", lines[2])
+	}
+}
+
+func TestWithDeduplication_DifferentFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDeduplication())
+	logger.LogEvent(&fxevent.Run{Name: "a", Kind: "provide"})
+	logger.LogEvent(&fxevent.Run{Name: "b", Kind: "provide"})
+	logger.LogEvent(&fxevent.Started{})
+	if got := strings.Count(buf.String(), "\n"); got != 3 {
+		t.Errorf("Expected 3 records, got %d: %s", got, buf.String())
+	}
+	if strings.Contains(buf.String(), "repeat_count") {
+		t.Error("Expected distinct records not to be collapsed")
+	}
+}
+
+func TestWithDemoteAfterStart(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithDemoteAfterStart())
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "before"})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "after"})
+	logger.LogEvent(&fxevent.Run{Name: "late", Kind: "provide"})
+	logger.LogEvent(&fxevent.OnStopExecuting{FunctionName: "f", CallerName: "c"})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 records, got %d", len(lines))
+	}
+	for i, want := range []string{"info", "info", "debug", "debug", "info"} {
+		if !strings.Contains(lines[i], `"level":"`+want+`"`) {
+			t.Errorf("Expected record %d at %s level, got %s", i, want, lines[i])
+		}
+	}
+}
+
+func TestWithExecutedHooksOnly(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithExecutedHooksOnly())
+	logger.LogEvent(&fxevent.OnStartExecuting{FunctionName: "f", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuting{FunctionName: "f", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c"})
+	out := buf.String()
+	if strings.Contains(out, "executing") {
+		t.Errorf("Expected executing records to be skipped, got %s", out)
+	}
+	if !strings.Contains(out, "OnStart hook executed") || !strings.Contains(out, "OnStop hook executed") {
+		t.Errorf("Expected executed records, got %s", out)
+	}
+}
+
+func TestWithMinHookRuntime(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithMinHookRuntime(100 * time.Millisecond))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "fast", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "slow", CallerName: "c", Runtime: time.Second})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "fastfail", CallerName: "c", Runtime: time.Millisecond, Err: errors.New("fail")})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "faststop", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Run{Name: "fastrun", Kind: "provide", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Run{Name: "slowrun", Kind: "provide", Runtime: time.Second})
+	out := buf.String()
+	for _, unwanted := range []string{`"fast"`, "faststop", "fastrun"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected %s to be skipped, got %s", unwanted, out)
+		}
+	}
+	for _, want := range []string{`"slow"`, "fastfail", "slowrun"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s to be logged, got %s", want, out)
+		}
+	}
+}
+
+func TestWithModuleLevel(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithModuleLevel("vendor", zerolog.DebugLevel))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "a", OutputTypeNames: []string{"A"}, ModuleName: "vendor"})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "b", OutputTypeNames: []string{"B"}, ModuleName: "child",
+		ModuleTrace: []string{"main.New (main.go:12)", "main.main (main.go:10) (child)", "main.main (main.go:5) (vendor)"}})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "c", ModuleName: "app"})
+	logger.LogEvent(&fxevent.Run{Name: "d", Kind: "provide", ModuleName: "vendor", Err: errors.New("fail")})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected 4 records, got %d", len(lines))
+	}
+	for i, want := range []string{"debug", "debug", "info", "error"} {
+		if !strings.Contains(lines[i], `"level":"`+want+`"`) {
+			t.Errorf("Expected record %d at %s level, got %s", i, want, lines[i])
+		}
+	}
+}
+
+func TestWithLevels(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithLogLevel(zerolog.DebugLevel), WithErrorLevel(zerolog.WarnLevel))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("fail")})
+	out := buf.String()
+	if !strings.Contains(out, `"level":"debug"`) || !strings.Contains(out, `"level":"warn"`) {
+		t.Errorf("Expected configured levels, got %s", out)
+	}
+}
+
+func TestWithoutStackTraces(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithoutStackTraces())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "c", OutputTypeNames: []string{"T"}, StackTrace: []string{"s"}, ModuleTrace: []string{"m"}})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "fn", Trace: "t", Err: errors.New("fail")})
+	out := buf.String()
+	for _, unwanted := range []string{"stacktrace", "moduletrace", `"stack"`} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected %s to be omitted, got %s", unwanted, out)
+		}
+	}
+}
+
+func TestWithSlowRunThreshold(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSlowRunThreshold(100*time.Millisecond), WithMinHookRuntime(time.Second))
+	logger.LogEvent(&fxevent.Run{Name: "fast", Kind: "provide", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Run{Name: "slow", Kind: "decorate", Runtime: 500 * time.Millisecond})
+	out := buf.String()
+	if strings.Contains(out, "fast") {
+		t.Errorf("Expected fast run to be skipped, got %s", out)
+	}
+	want := `{"level":"warn","name":"slow","kind":"decorate","runtime":"500ms","slow":true,"message":"run"}`
+	if !strings.Contains(out, want) {
+		t.Errorf("Expected %s, got %s", want, out)
+	}
+}
+
+func TestWithTracesOnError(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithTracesOnError())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, StackTrace: []string{"main.go:10"}, ModuleTrace: []string{"main.go:5"}})
+	if strings.Contains(buf.String(), "stacktrace") || strings.Contains(buf.String(), "moduletrace") {
+		t.Errorf("Expected no traces on a successful provide, got %s", buf.String())
+	}
+	buf.Reset()
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", StackTrace: []string{"main.go:10"}, ModuleTrace: []string{"main.go:5"}, Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), `"stacktrace":["main.go:10"]`) || !strings.Contains(buf.String(), `"moduletrace":["main.go:5"]`) {
+		t.Errorf("Expected traces on a failed provide, got %s", buf.String())
+	}
+}
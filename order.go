@@ -0,0 +1,119 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// lifecyclePhase is a phase of the application lifecycle as seen through
+// its events.
+type lifecyclePhase int
+
+const (
+	phaseInit lifecyclePhase = iota
+	phaseStarting
+	phaseRollingBack
+	phaseRolledBack
+	phaseStarted
+	phaseStopping
+	phaseStopped
+)
+
+// orderValidator tracks the lifecycle phase and the hooks currently
+// executing to detect events that arrive out of the order fx emits them in.
+type orderValidator struct {
+	phase    lifecyclePhase
+	starting map[[2]string]int // executing OnStart hooks by callee and caller
+	stopping map[[2]string]int // executing OnStop hooks by callee and caller
+}
+
+func newOrderValidator() *orderValidator {
+	return &orderValidator{
+		starting: make(map[[2]string]int),
+		stopping: make(map[[2]string]int),
+	}
+}
+
+// check advances the state machine with event and returns a description of
+// the problem if event is out of order.
+func (v *orderValidator) check(event fxevent.Event) string {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuting:
+		if v.phase >= phaseStarted {
+			return "OnStart hook executing after Started"
+		}
+		v.phase = max(v.phase, phaseStarting)
+		v.starting[[2]string{e.FunctionName, e.CallerName}]++
+	case *fxevent.OnStartExecuted:
+		if !release(v.starting, [2]string{e.FunctionName, e.CallerName}) {
+			return "OnStartExecuted without OnStartExecuting"
+		}
+	case *fxevent.OnStopExecuting:
+		if v.phase == phaseStarted {
+			v.phase = phaseStopping
+		}
+		v.stopping[[2]string{e.FunctionName, e.CallerName}]++
+	case *fxevent.OnStopExecuted:
+		if !release(v.stopping, [2]string{e.FunctionName, e.CallerName}) {
+			return "OnStopExecuted without OnStopExecuting"
+		}
+	case *fxevent.RollingBack:
+		if v.phase >= phaseStarted {
+			return "RollingBack after Started"
+		}
+		v.phase = phaseRollingBack
+	case *fxevent.RolledBack:
+		if v.phase != phaseRollingBack {
+			return "RolledBack without RollingBack"
+		}
+		v.phase = phaseRolledBack
+	case *fxevent.Started:
+		if e.Err != nil {
+			return ""
+		}
+		switch {
+		case v.phase == phaseRollingBack || v.phase == phaseRolledBack:
+			return "Started after RollingBack"
+		case v.phase >= phaseStarted:
+			return "Started more than once"
+		}
+		v.phase = phaseStarted
+	case *fxevent.Stopping:
+		if v.phase < phaseStarted {
+			return "Stopping before Started"
+		}
+		v.phase = phaseStopping
+	case *fxevent.Stopped:
+		v.phase = phaseStopped
+	}
+	return ""
+}
+
+// release decrements the count of key in m, reporting whether it was
+// positive.
+func release(m map[[2]string]int, key [2]string) bool {
+	if m[key] == 0 {
+		return false
+	}
+	m[key]--
+	if m[key] == 0 {
+		delete(m, key)
+	}
+	return true
+}
+
+// validateOrder logs a warning if event arrives out of order.
+func (l *Logger) validateOrder(event fxevent.Event) {
+	if l.order == nil {
+		return
+	}
+	if problem := l.order.check(event); len(problem) > 0 {
+		l.at(zerolog.WarnLevel).
+			Str("event", eventName(event)).
+			Str("problem", problem).
+			Send(KindOutOfOrder)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestOrderValidator(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []fxevent.Event
+		want   string
+	}{
+		{
+			name: "normal lifecycle",
+			events: []fxevent.Event{
+				&fxevent.OnStartExecuting{FunctionName: "f", CallerName: "c"},
+				&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"},
+				&fxevent.Started{},
+				&fxevent.Stopping{Signal: os.Interrupt},
+				&fxevent.OnStopExecuting{FunctionName: "f", CallerName: "c"},
+				&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c"},
+				&fxevent.Stopped{},
+			},
+		},
+		{
+			name: "rollback",
+			events: []fxevent.Event{
+				&fxevent.OnStartExecuting{FunctionName: "f", CallerName: "c"},
+				&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("fail")},
+				&fxevent.RollingBack{StartErr: errors.New("fail")},
+				&fxevent.RolledBack{},
+				&fxevent.Started{Err: errors.New("fail")},
+			},
+		},
+		{
+			name:   "executed without executing",
+			events: []fxevent.Event{&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c"}},
+			want:   "OnStopExecuted without OnStopExecuting",
+		},
+		{
+			name: "started after rolling back",
+			events: []fxevent.Event{
+				&fxevent.RollingBack{StartErr: errors.New("fail")},
+				&fxevent.Started{},
+			},
+			want: "Started after RollingBack",
+		},
+		{
+			name:   "started twice",
+			events: []fxevent.Event{&fxevent.Started{}, &fxevent.Started{}},
+			want:   "Started more than once",
+		},
+		{
+			name:   "hook after started",
+			events: []fxevent.Event{&fxevent.Started{}, &fxevent.OnStartExecuting{FunctionName: "f", CallerName: "c"}},
+			want:   "OnStart hook executing after Started",
+		},
+		{
+			name:   "rolled back without rolling back",
+			events: []fxevent.Event{&fxevent.RolledBack{}},
+			want:   "RolledBack without RollingBack",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newOrderValidator()
+			var got string
+			for _, e := range tt.events {
+				if problem := v.check(e); len(problem) > 0 {
+					got = problem
+				}
+			}
+			if got != tt.want {
+				t.Errorf("check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithOrderValidation(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithOrderValidation())
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"})
+	want := `{"level":"warn","event":"OnStartExecuted","problem":"OnStartExecuted without OnStartExecuting","message":"lifecycle event out of order"}`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("Expected %s, got %s", want, buf.String())
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// override logs event with its OverrideHandler, if one is registered for
+// its type, and reports whether it did.
+func (l *Logger) override(event fxevent.Event) bool {
+	fn, ok := l.overrides[reflect.TypeOf(event)]
+	if !ok {
+		return false
+	}
+	// Keep records in order with any held back by deduplication.
+	l.flush()
+	lvl := l.logLvl
+	if eventError(event) != nil {
+		lvl = l.errorLvl
+	}
+	// The handler writes to a buffer, from which the record is read back
+	// into an entry, so that it is finished and written like any other.
+	var buf bytes.Buffer
+	record := zerolog.New(&buf)
+	fn(record.WithLevel(lvl), event)
+	l.emitted++
+	e := l.at(lvl)
+	if e.disabled || buf.Len() == 0 {
+		return true
+	}
+	if err := e.decode(buf.Bytes()); err != nil {
+		e.release()
+		return true
+	}
+	e.write()
+	return true
+}
+
+// decode sets the message and fields of the entry from a record encoded by
+// zerolog, keeping the order of its fields. The level is the entry's own.
+func (e *entry) decode(record []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(record))
+	dec.UseNumber()
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	fields, err := decodeFields(dec)
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch {
+		case f.key == zerolog.LevelFieldName:
+		case f.key == zerolog.MessageFieldName && f.kind == stringField:
+			e.msg = f.str
+		case f.key == zerolog.ErrorFieldName && f.kind == stringField:
+			e.fields = append(e.fields, field{key: f.key, kind: errorField, err: errors.New(f.str)})
+		default:
+			e.fields = append(e.fields, f)
+		}
+	}
+	return nil
+}
+
+// decodeFields reads the members of a JSON object whose opening brace has
+// been read, up to and including its closing brace. Null members are
+// dropped.
+func decodeFields(dec *json.Decoder) ([]field, error) {
+	var fields []field
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		f, ok, err := decodeValue(dec)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			f.key, _ = key.(string)
+			fields = append(fields, f)
+		}
+	}
+	_, err := dec.Token()
+	return fields, err
+}
+
+// decodeValue reads a JSON value as a field without a key, reporting false
+// for null. Arrays of strings and of objects keep their type; the elements
+// of other arrays are written as strings.
+func decodeValue(dec *json.Decoder) (field, bool, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return field{}, false, err
+	}
+	switch v := tok.(type) {
+	case string:
+		return field{kind: stringField, str: v}, true, nil
+	case bool:
+		f := field{kind: boolField}
+		if v {
+			f.num = 1
+		}
+		return f, true, nil
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return field{kind: intField, num: n}, true, nil
+		}
+		flt, err := v.Float64()
+		return field{kind: floatField, flt: flt}, true, err
+	case json.Delim:
+		if v == '{' {
+			sub, err := decodeFields(dec)
+			return field{kind: objectField, sub: sub}, true, err
+		}
+		return decodeArray(dec)
+	}
+	return field{}, false, nil
+}
+
+// decodeArray reads the elements of a JSON array whose opening bracket has
+// been read, up to and including its closing bracket.
+func decodeArray(dec *json.Decoder) (field, bool, error) {
+	var elems []field
+	for dec.More() {
+		f, ok, err := decodeValue(dec)
+		if err != nil {
+			return field{}, false, err
+		}
+		if ok {
+			elems = append(elems, f)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return field{}, false, err
+	}
+	if len(elems) > 0 && !slices.ContainsFunc(elems, func(f field) bool { return f.kind != objectField }) {
+		return field{kind: arrayField, sub: elems}, true, nil
+	}
+	strs := make([]string, len(elems))
+	for i, f := range elems {
+		if f.kind == stringField {
+			strs[i] = f.str
+		} else {
+			strs[i] = detailValue(f)
+		}
+	}
+	return field{kind: stringsField, strs: strs}, true, nil
+}
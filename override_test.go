@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestOverrideHandler(t *testing.T) {
+	logger, buf := newTestLoggerWith(OverrideHandler(&fxevent.Provided{}, func(ev *zerolog.Event, event fxevent.Event) {
+		e := event.(*fxevent.Provided)
+		if e.Err != nil {
+			ev.Err(e.Err).Msg("custom failure")
+			return
+		}
+		ev.Str("ctor", e.ConstructorName).Msg("custom provided")
+	}))
+
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.Bad()", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := buf.String()
+	if !strings.Contains(out, `{"level":"info","ctor":"main.New()","message":"custom provided"}`) {
+		t.Errorf("Expected the custom Provided record, got %s", out)
+	}
+	if !strings.Contains(out, `{"level":"error","error":"boom","message":"custom failure"}`) {
+		t.Errorf("Expected the custom failure at error level, got %s", out)
+	}
+	if !strings.Contains(out, `"message":"invoking"`) {
+		t.Errorf("Expected other events to keep their default handling, got %s", out)
+	}
+}
+
+func TestOverrideHandler_Discard(t *testing.T) {
+	logger, buf := newTestLoggerWith(OverrideHandler(&fxevent.Invoking{}, func(ev *zerolog.Event, _ fxevent.Event) {
+		ev.Discard()
+	}))
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if buf.Len() > 0 {
+		t.Errorf("Expected the discarded event not to be logged, got %s", buf.String())
+	}
+}
+
+func TestOverrideHandler_Redacted(t *testing.T) {
+	var sink sinkRecorder
+	logger := NewWithSink(&sink, WithRedactedTypes(`^example\.com/internal/`), WithDeniedFields("secret"),
+		OverrideHandler(&fxevent.Provided{}, func(ev *zerolog.Event, event fxevent.Event) {
+			e := event.(*fxevent.Provided)
+			ev.Str("constructor", e.ConstructorName).Str("secret", "hunter2").
+				Strs("types", e.OutputTypeNames).Err(errors.New("boom")).Msg("custom provided")
+		}))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/internal/db.New()", OutputTypeNames: []string{"*db.DB"}})
+
+	if len(sink) != 1 {
+		t.Fatalf("Expected the override's record to reach the sink, got %+v", sink)
+	}
+	r := sink[0]
+	if r.Message != "custom provided" {
+		t.Errorf("Expected the override's message, got %q", r.Message)
+	}
+	var ctor string
+	var err error
+	for _, f := range r.Fields {
+		switch f.Key {
+		case "constructor":
+			ctor, _ = f.Value.(string)
+		case "secret":
+			t.Errorf("Expected the denied field to be dropped, got %+v", r.Fields)
+		case zerolog.ErrorFieldName:
+			err, _ = f.Value.(error)
+		}
+	}
+	if ctor != redactedName {
+		t.Errorf("Expected the constructor to be redacted, got %q", ctor)
+	}
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("Expected the error to be kept as an error, got %+v", r.Fields)
+	}
+}
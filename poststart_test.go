@@ -0,0 +1,61 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithPostStartLogger(t *testing.T) {
+	after := &bytes.Buffer{}
+	al := zerolog.New(after)
+	logger, buf := newTestLoggerWith(WithPostStartLogger(&al))
+
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+
+	if !strings.Contains(buf.String(), "invoking") || !strings.Contains(buf.String(), `"started"`) {
+		t.Errorf("Expected boot records on the original logger, got %s", buf.String())
+	}
+	if strings.Contains(buf.String(), "received signal") {
+		t.Errorf("Expected no records on the original logger after start, got %s", buf.String())
+	}
+	if !strings.Contains(after.String(), "received signal") {
+		t.Errorf("Expected later records on the post-start logger, got %s", after.String())
+	}
+}
+
+func TestWithPostStartLogger_FailedStart(t *testing.T) {
+	after := &bytes.Buffer{}
+	al := zerolog.New(after)
+	logger, buf := newTestLoggerWith(WithPostStartLogger(&al))
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+	if after.Len() > 0 || !strings.Contains(buf.String(), "stop failed") {
+		t.Errorf("Expected no switch after a failed start, got %s", after.String())
+	}
+}
+
+func TestWithPostStartLevel(t *testing.T) {
+	errs := &bytes.Buffer{}
+	logger, buf := newTestLoggerWith(WithPostStartLevel(zerolog.ErrorLevel), WithLevelWriter(zerolog.ErrorLevel, errs))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+
+	if strings.Contains(buf.String(), "received signal") {
+		t.Errorf("Expected info records to be filtered after start, got %s", buf.String())
+	}
+	if !strings.Contains(errs.String(), "stop failed") {
+		t.Errorf("Expected level routes to survive the switch, got %s", errs.String())
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// options combines several options into one.
+func options(opts ...Option) Option {
+	return func(l *Logger) {
+		for _, opt := range opts {
+			opt(l)
+		}
+	}
+}
+
+// PresetQuiet logs only errors and lifecycle milestones, with a summary of
+// the startup attached to the Started record and no stack traces.
+func PresetQuiet() Option {
+	return options(
+		WithQuietStartup(),
+		WithoutFxInternals(),
+		WithExecutedHooksOnly(),
+		WithoutStackTraces(),
+	)
+}
+
+// PresetDefault logs the application's own graph and hook records without
+// fx's internal constructors, the redundant "hook executing" records or stack
+// traces, and demotes graph records to debug level once started.
+func PresetDefault() Option {
+	return options(
+		WithoutFxInternals(),
+		WithExecutedHooksOnly(),
+		WithDemoteAfterStart(),
+		WithoutStackTraces(),
+	)
+}
+
+// PresetVerbose logs every record at info level, including fx's internal
+// constructors, the "hook executing" records, Decorated events and stack
+// and module traces. It undoes the options given before it that hide
+// records or traces, such as those of PresetQuiet and PresetDefault, so it
+// can be layered over a shared configuration to debug an application.
+func PresetVerbose() Option {
+	return options(
+		WithLogLevel(zerolog.InfoLevel),
+		WithErrorLevel(zerolog.ErrorLevel),
+		WithDecorations(),
+		func(l *Logger) {
+			l.quiet = false
+			l.skipFxInternals = false
+			l.executedOnly = false
+			l.demote = false
+			l.minRuntime = 0
+			l.noTraces = false
+			l.errorTraces = false
+		},
+	)
+}
+
+// PresetDebug is PresetVerbose at debug level, so lifecycle output only
+// appears when debug logging is enabled.
+func PresetDebug() Option {
+	return options(
+		PresetVerbose(),
+		WithLogLevel(zerolog.DebugLevel),
+	)
+}
+
+// PresetFor returns the options curated for a deployment environment, so
+// services share one definition of each instead of copying options:
+//
+//   - "dev" (or "development", "local") logs every record at info level with
+//     traces on failures, and a colored copy with emoji milestones to
+//     os.Stderr when it is a terminal;
+//   - "staging" (or "stage") is PresetDefault with the slowest OnStart hooks
+//     and a shutdown summary;
+//   - "prod" (or "production") is PresetQuiet with PresetProduction's data
+//     minimization.
+//
+// env is case-insensitive. Any other value, including "", gets
+// PresetDefault.
+func PresetFor(env string) Option {
+	if isDevEnv(env) {
+		return options(
+			PresetVerbose(),
+			WithTracesOnError(),
+			WithConsole(os.Stderr),
+			WithConsoleEmoji(),
+		)
+	}
+	switch strings.ToLower(env) {
+	case "staging", "stage":
+		return options(
+			PresetDefault(),
+			WithSlowestStartHooks(5),
+			WithShutdownSummary(),
+		)
+	case "prod", "production":
+		return options(
+			PresetQuiet(),
+			PresetProduction(),
+		)
+	default:
+		return PresetDefault()
+	}
+}
+
+// isDevEnv reports whether PresetFor treats env as a development
+// environment.
+func isDevEnv(env string) bool {
+	switch strings.ToLower(env) {
+	case "dev", "development", "local":
+		return true
+	}
+	return false
+}
+
+// PresetProduction keeps lifecycle records to the minimum a data
+// minimization policy allows: stack traces, module traces and the other
+// file and line information are dropped, qualified names are shortened to
+// their last package ("server.New()" rather than
+// "example.com/app/server.New()") and only scalar fields are kept, so
+// summaries attached to records are dropped as well. It can be combined
+// with the other presets.
+func PresetProduction() Option {
+	return options(
+		WithoutStackTraces(),
+		func(l *Logger) {
+			l.minimal = true
+		},
+	)
+}
+
+// PresetCompact minimizes the bytes of each record, for embedded systems
+// and serverless platforms that bill by the byte: keys are abbreviated
+// (fn for the hook, function or constructor, clr, rt, mod, ty, sig and err)
+// and stack and module traces, private and the run kind are dropped.
+// zerolog's own keys can be shortened through zerolog.LevelFieldName and
+// friends.
+func PresetCompact() Option {
+	return options(
+		WithoutStackTraces(),
+		func(l *Logger) {
+			l.schema = compactSchema
+		},
+	)
+}
+
+// PresetGCP formats records for Google Cloud Logging, as used by Cloud Run
+// and GKE: a severity field (DEBUG, INFO, WARNING, ERROR, ...) derived from
+// each record's level and logging.googleapis.com/labels holding the module
+// and lifecycle phase ("init", "start", "rollback" or "stop").
+func PresetGCP() Option {
+	return func(l *Logger) {
+		l.schema = gcpSchema
+	}
+}
+
+// PresetDatadog maps records to Datadog's standard attributes: status from
+// the record's level, duration in nanoseconds, error.message and error.stack,
+// and dd.trace_id and dd.span_id when trace correlation is enabled, so
+// lifecycle logs correlate with APM.
+func PresetDatadog() Option {
+	return func(l *Logger) {
+		l.schema = datadogSchema
+	}
+}
+
+// PresetOTel uses OpenTelemetry semantic-convention attribute names:
+// code.function for the hook, constructor or function, code.filepath and
+// code.lineno from the first stack frame, and exception.message,
+// exception.type and exception.stacktrace for errors, so records are
+// portable across OTel-native backends.
+func PresetOTel() Option {
+	return func(l *Logger) {
+		l.schema = otelSchema
+	}
+}
+
+// PresetSplunk structures records as Splunk HTTP Event Collector events,
+// {"time":...,"sourcetype":...,"event":{...}}, with the record's level,
+// fields and message inside event, so they can be sent to HEC without a
+// transform step. sourcetype defaults to "_json" if empty. The zerolog
+// logger should not add its own timestamp.
+func PresetSplunk(sourcetype string) Option {
+	if len(sourcetype) == 0 {
+		sourcetype = "_json"
+	}
+	return func(l *Logger) {
+		l.schema = splunkSchema(sourcetype)
+	}
+}
+
+// PresetGELF formats records as Graylog GELF 1.1 messages: version, host,
+// short_message, full_message holding the stack trace of failures, a
+// timestamp in epoch seconds, the numeric syslog level and every other field
+// prefixed with an underscore, such as _callee. Nested objects are flattened
+// into keys such as _by_module_db_constructors and durations are reported in
+// milliseconds, as _runtime_ms. host defaults to the machine's hostname if
+// empty. The zerolog logger should not add its own timestamp.
+func PresetGELF(host string) Option {
+	if len(host) == 0 {
+		host, _ = os.Hostname()
+	}
+	return func(l *Logger) {
+		l.schema = gelfSchema(host)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func presetEvents() []fxevent.Event {
+	return []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}},
+		&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.Server"}, StackTrace: []string{"s"}},
+		&fxevent.OnStartExecuting{FunctionName: "f", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"},
+		&fxevent.Invoked{FunctionName: "fn", Trace: "trace", Err: errors.New("invoke fail")},
+		&fxevent.Started{},
+		&fxevent.Invoking{FunctionName: "late"},
+	}
+}
+
+func TestPresets(t *testing.T) {
+	tests := []struct {
+		name    string
+		preset  Option
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "quiet",
+			preset:  PresetQuiet(),
+			want:    []string{"invoke fail", `"constructors":`},
+			notWant: []string{"*main.Server", "executed", "stacktrace", "trace"},
+		},
+		{
+			name:    "default",
+			preset:  PresetDefault(),
+			want:    []string{"*main.Server", "OnStart hook executed", `"level":"debug","function":"late"`},
+			notWant: []string{"fx.Lifecycle", "executing", "stacktrace"},
+		},
+		{
+			name:   "verbose",
+			preset: PresetVerbose(),
+			want:   []string{"fx.Lifecycle", "executing", "stacktrace", `"level":"info","function":"late"`},
+		},
+		{
+			name:   "debug",
+			preset: PresetDebug(),
+			want:   []string{"fx.Lifecycle", "stacktrace", `"level":"debug","constructor"`, `"level":"error"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, buf := newTestLoggerWith(tt.preset)
+			for _, e := range presetEvents() {
+				logger.LogEvent(e)
+			}
+			out := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected output to contain %s, got %s", want, out)
+				}
+			}
+			for _, unwanted := range tt.notWant {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("Expected output not to contain %s, got %s", unwanted, out)
+				}
+			}
+		})
+	}
+}
+
+func TestPresetVerbose_Overrides(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetQuiet(), PresetDefault(), WithMinHookRuntime(time.Hour), PresetVerbose())
+	for _, e := range presetEvents() {
+		logger.LogEvent(e)
+	}
+	logger.LogEvent(&fxevent.Decorated{DecoratorName: "main.Wrap()", OutputTypeNames: []string{"*main.Server"}})
+	out := buf.String()
+	for _, want := range []string{"fx.Lifecycle", "OnStart hook executing", "OnStart hook executed", "stacktrace", `"level":"info","function":"late"`, `"message":"decorated"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected PresetVerbose to undo earlier options and log %s, got %s", want, out)
+		}
+	}
+}
+
+func TestPresetFor(t *testing.T) {
+	tests := []struct {
+		env     string
+		want    []string
+		notWant []string
+	}{
+		{
+			env:  "dev",
+			want: []string{"fx.Lifecycle", "executing", `"level":"info","function":"late"`},
+		},
+		{
+			env:     "Staging",
+			want:    []string{"*main.Server", "slowest OnStart hooks", `"level":"debug","function":"late"`},
+			notWant: []string{"fx.Lifecycle", "stacktrace"},
+		},
+		{
+			env:     "prod",
+			want:    []string{"invoke fail", `"constructors":`},
+			notWant: []string{"*main.Server", "executed", "trace"},
+		},
+		{
+			env:     "",
+			want:    []string{"*main.Server", "OnStart hook executed"},
+			notWant: []string{"fx.Lifecycle", "executing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			logger, buf := newTestLoggerWith(PresetFor(tt.env))
+			for _, e := range presetEvents() {
+				logger.LogEvent(e)
+			}
+			out := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("Expected output to contain %s, got %s", want, out)
+				}
+			}
+			for _, unwanted := range tt.notWant {
+				if strings.Contains(out, unwanted) {
+					t.Errorf("Expected output not to contain %s, got %s", unwanted, out)
+				}
+			}
+		})
+	}
+}
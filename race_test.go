@@ -0,0 +1,10 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+//go:build race
+
+package fxeventzerolog
+
+// raceEnabled reports whether the race detector is enabled. It makes
+// sync.Pool drop entries at random, so allocation counts are not checked.
+const raceEnabled = true
@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter limits the number of events of each type that are logged.
+type rateLimiter struct {
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter // by event type name
+	dropped  map[string]int           // events dropped since the last summary
+}
+
+// allow reports whether an event of the named type may be logged at now.
+func (r *rateLimiter) allow(name string, now time.Time) bool {
+	lim, ok := r.limiters[name]
+	if !ok {
+		lim = rate.NewLimiter(r.limit, r.burst)
+		r.limiters[name] = lim
+	}
+	if lim.AllowN(now, 1) {
+		return true
+	}
+	r.dropped[name]++
+	return false
+}
+
+// allowEvent applies the rate limit to event. When an event type becomes
+// allowed again after some were dropped, a summary record is logged first.
+func (l *Logger) allowEvent(event fxevent.Event) bool {
+	if l.limiter == nil {
+		return true
+	}
+	name := eventName(event)
+	if !l.limiter.allow(name, l.clock.Now()) {
+		return false
+	}
+	l.summarizeDropped(name)
+	return true
+}
+
+// summarizeDropped logs a summary record for the events of the named type
+// that were dropped by the rate limiter, if any.
+func (l *Logger) summarizeDropped(name string) {
+	n := l.limiter.dropped[name]
+	if n == 0 {
+		return
+	}
+	delete(l.limiter.dropped, name)
+	e := l.at(zerolog.WarnLevel)
+	e = e.Str("event", name).Int("suppressed", n)
+	if _, ok := l.templates[KindEventsSuppressed]; ok {
+		e.Send(KindEventsSuppressed)
+		return
+	}
+	// The message is a template whichever table it comes from.
+	e.MsgTemplate(parseTemplate(l.message(KindEventsSuppressed)))
+}
+
+// summarizeAllDropped logs summary records for every event type with
+// dropped events.
+func (l *Logger) summarizeAllDropped() {
+	if l.limiter == nil {
+		return
+	}
+	for name := range l.limiter.dropped {
+		l.summarizeDropped(name)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithRateLimit(0, 2))
+	for range 5 {
+		logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("fail")})
+	}
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "fn"})
+	if got := strings.Count(buf.String(), "OnStart hook failed"); got != 2 {
+		t.Errorf("Expected 2 hook records, got %d", got)
+	}
+	if !strings.Contains(buf.String(), "invoking") {
+		t.Error("Expected other event types to have their own limit")
+	}
+
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, "suppressed 3 similar events") || !strings.Contains(out, `"event":"OnStartExecuted"`) {
+		t.Errorf("Expected suppression summary, got %s", out)
+	}
+}
+
+func TestEventName(t *testing.T) {
+	if got := eventName(&fxevent.OnStopExecuted{}); got != "OnStopExecuted" {
+		t.Errorf("eventName() = %q, want OnStopExecuted", got)
+	}
+}
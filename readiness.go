@@ -0,0 +1,51 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"sync"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// lifecycleSignals holds the channels closed by the Started and Stopped
+// events.
+type lifecycleSignals struct {
+	ready, done         chan struct{}
+	readyOnce, doneOnce sync.Once
+}
+
+func newLifecycleSignals() *lifecycleSignals {
+	return &lifecycleSignals{
+		ready: make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// observe closes the channels signaled by event.
+func (s *lifecycleSignals) observe(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.Started:
+		if e.Err == nil {
+			s.readyOnce.Do(func() { close(s.ready) })
+		} else {
+			s.doneOnce.Do(func() { close(s.done) })
+		}
+	case *fxevent.Stopped:
+		s.doneOnce.Do(func() { close(s.done) })
+	}
+}
+
+// Ready returns a channel that is closed once the application has started
+// successfully, so health servers and readiness probes can key off the fx
+// lifecycle. It is never closed if the application fails to start.
+func (l *Logger) Ready() <-chan struct{} {
+	return l.signals.ready
+}
+
+// Done returns a channel that is closed once the application has stopped,
+// or has failed to start and been rolled back.
+func (l *Logger) Done() <-chan struct{} {
+	return l.signals.done
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// closed reports whether ch has been closed.
+func closed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestReadyAndDone(t *testing.T) {
+	logger, _ := newTestLoggerWith()
+	if closed(logger.Ready()) || closed(logger.Done()) {
+		t.Fatal("Expected Ready and Done to be open before Started")
+	}
+
+	logger.LogEvent(&fxevent.Started{})
+	if !closed(logger.Ready()) {
+		t.Error("Expected Ready to be closed after Started")
+	}
+	if closed(logger.Done()) {
+		t.Error("Expected Done to be open until Stopped")
+	}
+
+	logger.LogEvent(&fxevent.Stopped{})
+	logger.LogEvent(&fxevent.Stopped{})
+	if !closed(logger.Done()) {
+		t.Error("Expected Done to be closed after Stopped")
+	}
+}
+
+func TestReadyAndDone_StartFailure(t *testing.T) {
+	logger, _ := newTestLoggerWith()
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	if closed(logger.Ready()) {
+		t.Error("Expected Ready to stay open after a failed start")
+	}
+	if !closed(logger.Done()) {
+		t.Error("Expected Done to be closed after a failed start")
+	}
+}
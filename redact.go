@@ -0,0 +1,175 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+)
+
+// redactedName replaces the names matched by WithRedactedTypes.
+const redactedName = "[redacted]"
+
+// redactedKeys are the fields holding type, constructor, decorator, function
+// and hook names.
+var redactedKeys = []string{"type", "constructor", "decorator", "previous_constructor", "callee", "caller", "function", "name"}
+
+// traceKeys are the fields holding stack and module trace frames.
+var traceKeys = []string{"stacktrace", "moduletrace"}
+
+// isRedacted reports whether s matches a WithRedactedTypes pattern.
+func (l *Logger) isRedacted(s string) bool {
+	for _, re := range l.redacted {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactTypes replaces the names of the entry, including those of nested
+// objects, the stack and module trace frames and the lines of an Invoked
+// stack that match a WithRedactedTypes pattern.
+func (l *Logger) redactTypes(e *entry) {
+	e.fields = l.redactFields(e.fields)
+}
+
+func (l *Logger) redactFields(fields []field) []field {
+	for i, f := range fields {
+		switch {
+		case f.kind == stringField && slices.Contains(redactedKeys, f.key):
+			if l.isRedacted(f.str) {
+				fields[i].str = redactedName
+			}
+		case f.kind == stringField && f.key == "stack":
+			fields[i].str = l.redactLines(f.str)
+		case f.kind == stringsField && slices.Contains(traceKeys, f.key):
+			fields[i].strs = l.redactFrames(f.strs)
+		case f.kind == objectField || f.kind == arrayField:
+			fields[i].sub = l.redactFields(slices.Clone(f.sub))
+		}
+	}
+	return fields
+}
+
+// redactFrames returns frames with those matching a pattern redacted. frames
+// itself is not modified, as it belongs to the event.
+func (l *Logger) redactFrames(frames []string) []string {
+	var out []string
+	for i, frame := range frames {
+		if !l.isRedacted(frame) {
+			continue
+		}
+		if out == nil {
+			out = slices.Clone(frames)
+		}
+		out[i] = redactedName
+	}
+	if out == nil {
+		return frames
+	}
+	return out
+}
+
+// redactLines redacts the lines of a multi-line stack trace that match a
+// pattern.
+func (l *Logger) redactLines(stack string) string {
+	lines := strings.Split(stack, "\n")
+	for i, line := range lines {
+		if l.isRedacted(strings.TrimSpace(line)) {
+			lines[i] = redactedName
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// publicName returns the name of a graph node as records show it: redacted
+// if it matches a WithRedactedTypes pattern, and hashed with WithHashedNames
+// unless it is a type.
+func (l *Logger) publicName(kind, name string) string {
+	if l.isRedacted(name) {
+		return redactedName
+	}
+	if l.hasher != nil && kind != typeNode {
+		return l.hasher.hash(name)
+	}
+	return name
+}
+
+// hashedKeys are the fields holding function, constructor and decorator
+// names, which WithHashedNames replaces with hashes.
+var hashedKeys = []string{"callee", "caller", "function", "constructor", "decorator", "previous_constructor", "name"}
+
+// hashedTraces are the fields WithHashedNames drops, as their frames reveal
+// the code structure the hashes hide.
+var hashedTraces = []string{"stacktrace", "moduletrace", "stack"}
+
+// nameHasher replaces names with short hashes, remembering the names each
+// hash stands for.
+type nameHasher struct {
+	salt   string
+	hashes map[string]string // hash of each name seen
+}
+
+// hash returns the hash of name: the first 8 bytes of the SHA-256 of the
+// salt and name, in hex.
+func (h *nameHasher) hash(name string) string {
+	if sum, ok := h.hashes[name]; ok {
+		return sum
+	}
+	sum := sha256.Sum256([]byte(h.salt + "\x00" + name))
+	hash := hex.EncodeToString(sum[:8])
+	h.hashes[name] = hash
+	return hash
+}
+
+// hashNames replaces the function, constructor and decorator names of the
+// entry, including those of summaries in nested objects, with their hashes,
+// and drops its stack and module traces.
+func (l *Logger) hashNames(e *entry) {
+	e.fields = slices.DeleteFunc(e.fields, func(f field) bool {
+		return slices.Contains(hashedTraces, f.key)
+	})
+	e.fields = l.hasher.hashFields(e.fields)
+}
+
+func (h *nameHasher) hashFields(fields []field) []field {
+	for i, f := range fields {
+		switch {
+		case f.kind == stringField && len(f.str) > 0 && slices.Contains(hashedKeys, f.key):
+			fields[i].str = h.hash(f.str)
+		case f.kind == objectField || f.kind == arrayField:
+			fields[i].sub = h.hashFields(slices.Clone(f.sub))
+		}
+	}
+	return fields
+}
+
+// NameTable returns the names replaced by WithHashedNames so far, keyed by
+// their hashes, to resolve the hashes in stored logs. It returns an empty
+// map without WithHashedNames.
+func (l *Logger) NameTable() map[string]string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	table := make(map[string]string)
+	if l.hasher != nil {
+		for name, hash := range l.hasher.hashes {
+			table[hash] = name
+		}
+	}
+	return table
+}
+
+// WriteNameTable writes the NameTable to w as a JSON object, so it can be
+// kept locally while the logs are shipped without the names.
+func (l *Logger) WriteNameTable(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(l.NameTable())
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithRedactedTypes(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithRedactedTypes(`^example\.com/internal/`, `\*internal\.`), WithDecorations())
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "example.com/internal/db.New()",
+		OutputTypeNames: []string{"*internal.DB", "*sql.DB"},
+	})
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*internal.Config"})
+	logger.LogEvent(&fxevent.Decorated{DecoratorName: "main.wrap()", OutputTypeNames: []string{"*internal.DB"}})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "example.com/internal/app.run()"})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := buf.String()
+	if strings.Contains(out, "internal.DB") || strings.Contains(out, "internal.Config") || strings.Contains(out, "internal/db") {
+		t.Errorf("Expected internal names to be redacted, got %s", out)
+	}
+	if n := strings.Count(out, `"type":"[redacted]"`); n != 3 {
+		t.Errorf("Expected 3 redacted types, got %d in %s", n, out)
+	}
+	for _, want := range []string{
+		`"constructor":"[redacted]"`,
+		`"type":"*sql.DB"`,
+		`"decorator":"main.wrap()"`,
+		`"function":"[redacted]"`,
+		`"function":"main.run()"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestWithRedactedTypes_AllNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.dot")
+	logger, buf := newTestLoggerWith(WithRedactedTypes(`^example\.com/internal/`), WithDuplicateProvideWarnings(),
+		WithGraphLog(), WithGraphFile(path))
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "example.com/internal/secret.NewThing()",
+		OutputTypeNames: []string{"*secret.Thing"},
+		StackTrace:      []string{"example.com/internal/secret.NewThing (thing.go:1)", "main.main (main.go:2)"},
+		ModuleTrace:     []string{"example.com/internal/secret.Module (thing.go:3) (secret)"},
+	})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewThing()", OutputTypeNames: []string{"*secret.Thing"}})
+	logger.LogEvent(&fxevent.Run{Name: "example.com/internal/secret.NewThing()", Kind: "provide"})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom"),
+		Trace: "example.com/internal/secret.NewThing()\n\t/src/thing.go:1\nmain.main()\n\t/src/main.go:2"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "example.com/internal/secret.start()", CallerName: "main.New()"})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	if strings.Contains(out, "example.com/internal") {
+		t.Errorf("Expected every internal name to be redacted, got %s", out)
+	}
+	for _, want := range []string{
+		`"stacktrace":["[redacted]","main.main (main.go:2)"]`,
+		`"moduletrace":["[redacted]"]`,
+		`"previous_constructor":"[redacted]"`,
+		`"name":"[redacted]"`,
+		`"callee":"[redacted]","caller":"main.New()"`,
+		`\"[redacted]\" -> \"*secret.Thing\"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+	if !strings.Contains(out, `"stack":"[redacted]\n\t/src/thing.go:1\nmain.main()`) {
+		t.Errorf("Expected the Invoked stack to be redacted, got %s", out)
+	}
+	if b, err := os.ReadFile(path); err != nil || strings.Contains(string(b), "example.com/internal") {
+		t.Errorf("Expected a redacted graph file, got %s (%v)", b, err)
+	}
+}
+
+func TestWithRedactedTypes_FieldLimits(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithRedactedTypes(`^example\.com/internal/.*\(\)$`), WithFieldLimits(0, 20))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/internal/secret.NewThing()", OutputTypeNames: []string{"*secret.Thing"}})
+
+	out := buf.String()
+	if strings.Contains(out, "example.com") || !strings.Contains(out, `"constructor":"[redacted]"`) {
+		t.Errorf("Expected the full name to be matched before truncation, got %s", out)
+	}
+}
+
+func TestWithRedactedTypes_Templates(t *testing.T) {
+	logger, buf := newTestLoggerWith(
+		WithRedactedTypes(`internal`),
+		WithMessageTemplates(map[EventKind]string{KindProvided: "{constructor} gives {type}"}),
+	)
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/internal/db.New()", OutputTypeNames: []string{"*internal.DB"}})
+
+	out := buf.String()
+	if strings.Contains(out, "internal") {
+		t.Errorf("Expected names to be redacted in the message too, got %s", out)
+	}
+	if !strings.Contains(out, `"message":"[redacted] gives [redacted]"`) {
+		t.Errorf("Expected the message rendered from the redacted fields, got %s", out)
+	}
+}
+
+func TestWithRedactedTypes_Errors(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithRedactedTypes(`internal`), WithSuppressedTypes(`^\*internal\.Cache$`))
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*internal.Config", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*internal.Cache"})
+
+	out := buf.String()
+	if !strings.Contains(out, `"type":"[redacted]"`) || !strings.Contains(out, `"error":"boom"`) {
+		t.Errorf("Expected a redacted supply error, got %s", out)
+	}
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("Expected suppression to match the original name, got %s", out)
+	}
+}
+
+func TestWithRedactedTypes_InvalidPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for an invalid pattern")
+		}
+	}()
+	WithRedactedTypes("(")
+}
+
+func TestWithHashedNames(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithHashedNames("salt"))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/app/db.New()", OutputTypeNames: []string{"*db.DB"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "example.com/app/db.(*DB).Start", CallerName: "example.com/app/db.New()"})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := buf.String()
+	if strings.Contains(out, "example.com") || strings.Contains(out, "main.run()") {
+		t.Errorf("Expected names to be hashed, got %s", out)
+	}
+	if !strings.Contains(out, `"type":"*db.DB"`) {
+		t.Errorf("Expected type names to be kept, got %s", out)
+	}
+
+	table := logger.NameTable()
+	if len(table) != 3 {
+		t.Fatalf("Expected 3 names in the table, got %v", table)
+	}
+	for hash, name := range table {
+		if len(hash) != 16 {
+			t.Errorf("Expected a 16 character hash, got %q", hash)
+		}
+		if !strings.Contains(out, `"`+hash+`"`) {
+			t.Errorf("Expected hash %s of %s in %s", hash, name, out)
+		}
+	}
+	if strings.Contains(out, "stacktrace") {
+		t.Errorf("Expected traces to be dropped, got %s", out)
+	}
+	ctor := (&nameHasher{salt: "salt", hashes: map[string]string{}}).hash("example.com/app/db.New()")
+	if table[ctor] != "example.com/app/db.New()" || strings.Count(out, ctor) != 2 {
+		t.Errorf("Expected a stable hash %s for the constructor and caller, got %v in %s", ctor, table, out)
+	}
+	other := (&nameHasher{salt: "other", hashes: map[string]string{}}).hash("example.com/app/db.New()")
+	if other == ctor {
+		t.Error("Expected the hash to depend on the salt")
+	}
+
+	var w strings.Builder
+	if err := logger.WriteNameTable(&w); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(w.String(), `"`+ctor+`": "example.com/app/db.New()"`) {
+		t.Errorf("Expected the name table as JSON, got %s", w.String())
+	}
+}
+
+func TestWithHashedNames_FieldLimits(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithHashedNames("salt"), WithFieldLimits(0, 20))
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "example.com/app/internal/first.Run()", Err: errors.New("boom"), Trace: "example.com/app/internal/first.Run()"})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "example.com/app/internal/second.Run()"})
+
+	out := buf.String()
+	if strings.Contains(out, "example.com") {
+		t.Errorf("Expected names and stacks to be hidden, got %s", out)
+	}
+	if table := logger.NameTable(); len(table) != 2 {
+		t.Errorf("Expected distinct hashes of the full names, got %v in %s", table, out)
+	}
+}
+
+func TestWithHashedNames_Templates(t *testing.T) {
+	logger, buf := newTestLoggerWith(
+		WithHashedNames("salt"),
+		WithMessageTemplates(map[EventKind]string{
+			KindProvided:        "{constructor} gives {type}",
+			KindOnStartExecuted: "{callee} started by {caller}",
+		}),
+	)
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/internal/db.New()", OutputTypeNames: []string{"*db.DB"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "example.com/internal/db.(*DB).Start", CallerName: "example.com/internal/db.New()"})
+
+	out := buf.String()
+	if strings.Contains(out, "example.com") {
+		t.Errorf("Expected names to be hashed in the message too, got %s", out)
+	}
+	hasher := &nameHasher{salt: "salt", hashes: map[string]string{}}
+	ctor, start := hasher.hash("example.com/internal/db.New()"), hasher.hash("example.com/internal/db.(*DB).Start")
+	for _, want := range []string{
+		`"message":"` + ctor + ` gives *db.DB"`,
+		`"message":"` + start + ` started by ` + ctor + `"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
+
+func TestWithHashedNames_Graph(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithHashedNames("salt"), WithGraphLog())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "example.com/app/db.New()", OutputTypeNames: []string{"*db.DB"}})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := buf.String()
+	hash := (&nameHasher{salt: "salt", hashes: map[string]string{}}).hash("example.com/app/db.New()")
+	if strings.Contains(out, "example.com") || !strings.Contains(out, `\"`+hash+`\" -> \"*db.DB\"`) {
+		t.Errorf("Expected a hashed graph, got %s", out)
+	}
+}
+
+func TestNameTable_WithoutHashing(t *testing.T) {
+	if table := NewDiscard().NameTable(); len(table) != 0 {
+		t.Errorf("Expected an empty name table, got %v", table)
+	}
+}
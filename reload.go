@@ -0,0 +1,215 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// ApplyConfig changes the level and filter settings of the live Logger to
+// those of c, including those set by its Env and Preset: the levels, fx
+// internals, the minimum hook runtime, suppressed and redacted types, and
+// allowed and denied fields. They change together, between two events.
+// Each setting is relative to the options the Logger was created with, not
+// to a previously applied config. The levels, fx internals and minimum hook
+// runtime that c sets replace those of the options, and those it leaves out
+// keep them. The filters apply on top of those of the options: a type
+// suppressed or redacted by either is suppressed or redacted, and a field is
+// written only if neither denies it and both allow it. Other settings, such
+// as the format and backend, only take effect when a Logger is created. If c
+// is invalid, the Logger is left unchanged.
+func (l *Logger) ApplyConfig(c Config) error {
+	opts, err := c.Options()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.base == nil {
+		// Only ApplyConfig changes these settings once the Logger is
+		// created, so they are still those of its options.
+		l.base = &optionSettings{
+			logLvl:          l.logLvl,
+			errorLvl:        l.errorLvl,
+			skipFxInternals: l.skipFxInternals,
+			minRuntime:      l.minRuntime,
+			suppressed:      l.suppressed,
+			redacted:        l.redacted,
+			allowedFields:   l.allowedFields,
+			deniedFields:    l.deniedFields,
+		}
+	}
+	base := l.base
+	s := NewDiscard(append([]Option{func(s *Logger) {
+		s.logLvl, s.errorLvl = base.logLvl, base.errorLvl
+		s.skipFxInternals = base.skipFxInternals
+		s.minRuntime = base.minRuntime
+	}}, opts...)...)
+	l.logLvl, l.errorLvl = s.logLvl, s.errorLvl
+	l.skipFxInternals = s.skipFxInternals
+	l.minRuntime = s.minRuntime
+	l.suppressed = slices.Concat(base.suppressed, s.suppressed)
+	l.redacted = slices.Concat(base.redacted, s.redacted)
+	l.allowedFields = intersectFields(base.allowedFields, s.allowedFields)
+	l.deniedFields = unionFields(base.deniedFields, s.deniedFields)
+	return nil
+}
+
+// optionSettings are the settings of a Logger that ApplyConfig changes, as
+// given by its options.
+type optionSettings struct {
+	logLvl, errorLvl            zerolog.Level
+	skipFxInternals             bool
+	minRuntime                  time.Duration
+	suppressed, redacted        []*regexp.Regexp
+	allowedFields, deniedFields map[string]struct{}
+}
+
+// intersectFields returns the fields both a and b allow, where a nil set
+// allows every field.
+func intersectFields(a, b map[string]struct{}) map[string]struct{} {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	}
+	fields := make(map[string]struct{})
+	for key := range a {
+		if _, ok := b[key]; ok {
+			fields[key] = struct{}{}
+		}
+	}
+	return fields
+}
+
+// unionFields returns the fields in a or b, or nil if there are none.
+func unionFields(a, b map[string]struct{}) map[string]struct{} {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	fields := make(map[string]struct{}, len(a)+len(b))
+	maps.Copy(fields, a)
+	maps.Copy(fields, b)
+	return fields
+}
+
+// ConfigWatcher keeps a Logger's settings in sync with a config file, such
+// as a mounted Kubernetes ConfigMap, applying them with ApplyConfig.
+type ConfigWatcher struct {
+	logger *Logger
+	path   string
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once // closes stop
+
+	mu   sync.Mutex // serializes reloads
+	seen []byte     // contents of the file last reloaded from, if any
+}
+
+// WatchConfigFile applies the config file at path, read as ConfigFromFile
+// does, to l and, if interval is positive, checks the file for changes every
+// interval and applies them. Changes that fail to load are reported with a
+// KindConfigReloadFailed warning, "failed to reload config", written like
+// l's other records, and l keeps its settings. It returns an error if the file cannot be applied initially.
+// Close the watcher to stop checking.
+func WatchConfigFile(l *Logger, path string, interval time.Duration) (*ConfigWatcher, error) {
+	w := &ConfigWatcher{
+		logger: l,
+		path:   path,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if err := w.Reload(); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		go w.run(interval)
+	} else {
+		close(w.done)
+	}
+	return w, nil
+}
+
+// Reload reads the config file and applies it to the Logger, leaving its
+// settings unchanged on error.
+func (w *ConfigWatcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	return w.apply(data)
+}
+
+// apply parses and applies the contents of the config file.
+func (w *ConfigWatcher) apply(data []byte) error {
+	w.seen = data
+	c, err := parseConfig(w.path, data)
+	if err != nil {
+		return err
+	}
+	return w.logger.ApplyConfig(c)
+}
+
+// run checks the config file every interval until the watcher is closed.
+func (w *ConfigWatcher) run(interval time.Duration) {
+	defer close(w.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+// check reloads the config file if its contents changed since the last
+// reload. A failed reload is only reported once per change.
+func (w *ConfigWatcher) check() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	switch {
+	case err != nil && w.seen == nil:
+		// The failure to read the file was already reported.
+		return
+	case err != nil:
+		w.seen = nil
+	case bytes.Equal(data, w.seen):
+		return
+	default:
+		err = w.apply(data)
+	}
+	if err != nil {
+		l := w.logger
+		l.mu.Lock()
+		l.detached(zerolog.WarnLevel).Str("path", w.path).Err(err).Send(KindConfigReloadFailed)
+		l.mu.Unlock()
+	}
+}
+
+// Close stops checking the config file for changes.
+// It is safe to call more than once, and concurrently.
+func (w *ConfigWatcher) Close() {
+	w.once.Do(func() { close(w.stop) })
+	<-w.done
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestApplyConfig(t *testing.T) {
+	logger, buf := newTestLoggerWith()
+	if err := logger.ApplyConfig(Config{Level: "debug", SkipFxInternals: true, DeniedFields: []string{"caller"}}); err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+
+	out := buf.String()
+	if strings.Contains(out, "fx.Lifecycle") || strings.Contains(out, "caller") {
+		t.Errorf("Expected the applied filters, got %s", out)
+	}
+	if !strings.Contains(out, `"level":"debug","callee":"main.start()"`) {
+		t.Errorf("Expected the applied level, got %s", out)
+	}
+
+	buf.Reset()
+	var ce *ConfigError
+	if err := logger.ApplyConfig(Config{Level: "loud"}); !errors.As(err, &ce) {
+		t.Errorf("Expected a ConfigError, got %v", err)
+	}
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()"})
+	if out := buf.String(); !strings.Contains(out, `"level":"debug"`) {
+		t.Errorf("Expected an invalid config to leave the settings unchanged, got %s", out)
+	}
+}
+
+func TestApplyConfig_Filters(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithRedactedTypes(`^\*secret\.`), WithDeniedFields("caller"), WithAllowedFields("callee", "caller", "type", "module"))
+	for range 2 {
+		// Applying a config twice keeps the filters of the options, and
+		// not those of the previous config.
+		if err := logger.ApplyConfig(Config{RedactedTypes: []string{`^\*vault\.`}, DeniedFields: []string{"module"}, AllowedFields: []string{"callee", "type", "module", "runtime"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*secret.Key", ModuleName: "keys"})
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*vault.Client", ModuleName: "keys"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: time.Millisecond})
+
+	out := buf.String()
+	if strings.Contains(out, "secret.Key") || strings.Contains(out, "vault.Client") {
+		t.Errorf("Expected the types redacted by the options and the config, got %s", out)
+	}
+	for _, key := range []string{"caller", "module", "runtime"} {
+		if strings.Contains(out, `"`+key+`"`) {
+			t.Errorf("Expected %s to be filtered by the options or the config, got %s", key, out)
+		}
+	}
+	if !strings.Contains(out, `"callee":"main.start()"`) {
+		t.Errorf("Expected the fields allowed by both to be written, got %s", out)
+	}
+
+	buf.Reset()
+	if err := logger.ApplyConfig(Config{}); err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Supplied{TypeName: "*vault.Client", ModuleName: "keys"})
+	if out := buf.String(); !strings.Contains(out, "vault.Client") || !strings.Contains(out, `"module":"keys"`) {
+		t.Errorf("Expected an empty config to restore the filters of the options, got %s", out)
+	}
+}
+
+func TestWatchConfigFile_Partial(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fxlog.yaml")
+	if err := os.WriteFile(path, []byte("denied_fields: [module]\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	logger, buf := newTestLoggerWith(WithLogLevel(zerolog.DebugLevel), WithoutFxInternals(), WithMinHookRuntime(10*time.Millisecond))
+	w, err := WatchConfigFile(logger, path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	check := func() {
+		t.Helper()
+		buf.Reset()
+		logger.LogEvent(&fxevent.Provided{ConstructorName: "go.uber.org/fx.New.func1()", OutputTypeNames: []string{"fx.Lifecycle"}})
+		logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.fast()", CallerName: "main.New()", Runtime: time.Millisecond})
+		logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.slow()", CallerName: "main.New()", Runtime: 20 * time.Millisecond})
+		out := buf.String()
+		if strings.Contains(out, "fx.Lifecycle") || strings.Contains(out, "main.fast()") {
+			t.Errorf("Expected the options' filters to be kept by a partial config, got %s", out)
+		}
+		if !strings.Contains(out, `"level":"debug","callee":"main.slow()"`) {
+			t.Errorf("Expected the options' level to be kept by a partial config, got %s", out)
+		}
+	}
+	check()
+
+	replaceFile(t, path, "level: warn\n")
+	if err := w.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.slow()", CallerName: "main.New()", Runtime: 20 * time.Millisecond})
+	if out := buf.String(); !strings.Contains(out, `"level":"warn"`) {
+		t.Errorf("Expected the level the config sets, got %s", out)
+	}
+
+	replaceFile(t, path, "denied_fields: [module]\n")
+	if err := w.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	check()
+}
+
+func TestConfigWatcher_ReloadFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fxlog.yaml")
+	if err := os.WriteFile(path, []byte("level: debug\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var sink sinkRecorder
+	logger := NewWithSink(&sink, WithMessageCatalog(MessageMap{KindConfigReloadFailed: "échec du rechargement"}))
+	w, err := WatchConfigFile(logger, path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	logger.LogEvent(&fxevent.Started{})
+
+	replaceFile(t, path, "level: loud\n")
+	w.check()
+	if len(sink) != 2 {
+		t.Fatalf("Expected the failure to be written to the sink, got %+v", sink)
+	}
+	r := sink[1]
+	if r.Message != "échec du rechargement" || r.Level != zerolog.WarnLevel || r.Event != nil {
+		t.Errorf("Expected a warning with the catalog's message and no event, got %+v", r)
+	}
+}
+
+func TestConfigWatcher_Close(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fxlog.yaml")
+	if err := os.WriteFile(path, []byte("level: debug\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	w, err := WatchConfigFile(NewDiscard(), path, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.Close()
+		}()
+	}
+	wg.Wait()
+	w.Close()
+}
+
+// replaceFile replaces the file at path with content in one step, as a
+// Kubernetes ConfigMap update does, so a watcher never reads it half written.
+func replaceFile(t *testing.T, path, content string) {
+	t.Helper()
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWatchConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fxlog.yaml")
+	if err := os.WriteFile(path, []byte("level: debug\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	logger, buf := newTestLoggerWith()
+	w, err := WatchConfigFile(logger, path, 5*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	// The watcher writes its warnings under the Logger's lock.
+	output := func(reset bool) string {
+		logger.mu.Lock()
+		defer logger.mu.Unlock()
+		out := buf.String()
+		if reset {
+			buf.Reset()
+		}
+		return out
+	}
+
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if out := output(true); !strings.Contains(out, `"level":"debug"`) {
+		t.Fatalf("Expected the initial config, got %s", out)
+	}
+
+	replaceFile(t, path, "level: warn\n")
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+		if out := output(true); strings.Contains(out, `"level":"warn"`) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the changed config to be applied")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	replaceFile(t, path, "level: loud\n")
+	for !strings.Contains(output(false), "failed to reload config") {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected a reload failure warning, got %s", output(false))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	output(true)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if out := output(true); !strings.Contains(out, `"level":"warn"`) {
+		t.Errorf("Expected an invalid change to keep the settings, got %s", out)
+	}
+}
+
+func TestConfigWatcher_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fxlog.json")
+	if err := os.WriteFile(path, []byte(`{"level": "warn"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	logger, buf := newTestLoggerWith()
+	w, err := WatchConfigFile(logger, path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if err := os.WriteFile(path, []byte(`{"level": "error"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if out := buf.String(); !strings.Contains(out, `"level":"error"`) {
+		t.Errorf("Expected the reloaded level, got %s", out)
+	}
+
+	if _, err := WatchConfigFile(logger, filepath.Join(t.TempDir(), "missing.yaml"), 0); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
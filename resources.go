@@ -0,0 +1,17 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "runtime"
+
+// resourceFields adds a snapshot of the process's resource usage to the
+// entry: the number of goroutines, bytes of in-use heap spans and the number
+// of completed GC cycles.
+func resourceFields(e *entry) *entry {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	return e.Int("goroutines", runtime.NumGoroutine()).
+		Int("heap_inuse", int(ms.HeapInuse)).
+		Int("gc_count", int(ms.NumGC))
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithResourceSnapshot(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithResourceSnapshot())
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("fail")})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 records, got %d: %s", len(lines), buf.String())
+	}
+	for i, msg := range []string{"started", "stopped", "stop failed"} {
+		for _, want := range []string{`"goroutines":`, `"heap_inuse":`, `"gc_count":`, `"message":"` + msg + `"`} {
+			if !strings.Contains(lines[i], want) {
+				t.Errorf("Expected record %d to contain %s, got %s", i, want, lines[i])
+			}
+		}
+	}
+}
+
+func TestResourceSnapshot_DefaultOff(t *testing.T) {
+	logger, buf := newTestLogger()
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{})
+	if strings.Contains(buf.String(), "goroutines") || strings.Contains(buf.String(), `"stopped"`) {
+		t.Errorf("Expected no resource snapshot by default, got %s", buf.String())
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// levelRoute sends records at min level or above to its own logger.
+type levelRoute struct {
+	min    zerolog.Level
+	w      io.Writer
+	logger *zerolog.Logger // the main logger writing to w
+}
+
+// switchAfterStart replaces the zerolog logger with the post-start logger,
+// if one is configured and it has not been switched to yet. Level routes are
+// rebuilt on top of the new logger.
+func (l *Logger) switchAfterStart() {
+	if l.postStart == nil {
+		return
+	}
+	l.inner = l.postStart(l.inner)
+	l.postStart = nil
+	for i, r := range l.routes {
+		logger := l.inner.Output(r.w)
+		l.routes[i].logger = &logger
+	}
+}
+
+// output returns the zerolog logger that records at lvl are written to: the
+// route with the highest minimum level not above lvl, or the main logger.
+func (l *Logger) output(lvl zerolog.Level) *zerolog.Logger {
+	for _, r := range l.routes {
+		if lvl >= r.min {
+			return r.logger
+		}
+	}
+	return l.inner
+}
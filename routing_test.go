@@ -0,0 +1,39 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithLevelWriter(t *testing.T) {
+	warnings, errs := &bytes.Buffer{}, &bytes.Buffer{}
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).With().Str("app", "test").Logger()
+	logger := New(&zl,
+		WithLevelWriter(zerolog.ErrorLevel, errs),
+		WithLevelWriter(zerolog.WarnLevel, warnings),
+		WithDuplicateProvideWarnings(),
+	)
+
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "a()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "b()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+
+	if strings.Contains(buf.String(), "warn") || strings.Contains(buf.String(), "boom") {
+		t.Errorf("Expected only info records on the main writer, got %s", buf.String())
+	}
+	if !strings.Contains(warnings.String(), "type provided by multiple constructors") || strings.Contains(warnings.String(), "boom") {
+		t.Errorf("Expected only warnings on the warning writer, got %s", warnings.String())
+	}
+	if !strings.Contains(errs.String(), `"app":"test"`) || !strings.Contains(errs.String(), "boom") {
+		t.Errorf("Expected errors with context on the error writer, got %s", errs.String())
+	}
+}
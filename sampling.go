@@ -0,0 +1,49 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// firstThenEvery is a zerolog.Sampler that passes the first events and then
+// every thereafter-th event.
+type firstThenEvery struct {
+	first      uint64
+	thereafter uint64
+	count      atomic.Uint64
+}
+
+var _ zerolog.Sampler = (*firstThenEvery)(nil)
+
+// Sample implements zerolog.Sampler.
+func (s *firstThenEvery) Sample(zerolog.Level) bool {
+	n := s.count.Add(1)
+	if n <= s.first {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.first)%s.thereafter == 0
+}
+
+// sampled reports whether event passes the graph sampler. Only successful
+// Provided and Run events are sampled; everything else always passes.
+func (l *Logger) sampled(event fxevent.Event) bool {
+	if l.graphSampler == nil {
+		return true
+	}
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		if e.Err == nil {
+			return l.graphSampler.Sample(l.logLvl)
+		}
+	case *fxevent.Run:
+		if e.Err == nil {
+			return l.graphSampler.Sample(l.logLvl)
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestFirstThenEvery(t *testing.T) {
+	s := &firstThenEvery{first: 2, thereafter: 3}
+	var got []bool
+	for range 8 {
+		got = append(got, s.Sample(zerolog.InfoLevel))
+	}
+	want := []bool{true, true, false, false, true, false, false, true}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Sample() sequence = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestWithGraphSampling(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithGraphSampling(1, 0))
+	for range 3 {
+		logger.LogEvent(&fxevent.Run{Name: "ctor", Kind: "provide"})
+		logger.LogEvent(&fxevent.Provided{ConstructorName: "ctor", OutputTypeNames: []string{"T"}})
+	}
+	logger.LogEvent(&fxevent.Run{Name: "ctor", Kind: "provide", Err: errors.New("fail")})
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "fn"})
+	out := buf.String()
+	if got := strings.Count(out, `"message":"run"`) + strings.Count(out, `"message":"provided"`); got != 1 {
+		t.Errorf("Expected 1 sampled graph record, got %d: %s", got, out)
+	}
+	if !strings.Contains(out, "error returned") || !strings.Contains(out, "invoking") {
+		t.Error("Expected errors and other events not to be sampled")
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// take removes the field with the given key from the entry and returns it.
+func (e *entry) take(key string) (field, bool) {
+	i := slices.IndexFunc(e.fields, func(f field) bool { return f.key == key })
+	if i < 0 {
+		return field{}, false
+	}
+	f := e.fields[i]
+	e.fields = slices.Delete(e.fields, i, i+1)
+	return f, true
+}
+
+// rename moves the field with key from to the dotted path to, nesting it in
+// objects as needed. It reports whether the field was present.
+func (e *entry) rename(from, to string) bool {
+	f, ok := e.take(from)
+	if ok {
+		e.nest(to, f)
+	}
+	return ok
+}
+
+// nest adds f under the dotted path, merging into existing objects, so that
+// "event.duration" becomes {"event":{"duration":...}}.
+func (e *entry) nest(path string, f field) {
+	e.fields = nestField(e.fields, strings.Split(path, "."), f)
+}
+
+func nestField(fields []field, path []string, f field) []field {
+	if len(path) == 1 {
+		f.key = path[0]
+		return append(fields, f)
+	}
+	for i := range fields {
+		if fields[i].key == path[0] && fields[i].kind == objectField {
+			fields[i].sub = nestField(slices.Clone(fields[i].sub), path[1:], f)
+			return fields
+		}
+	}
+	return append(fields, field{key: path[0], kind: objectField, sub: nestField(nil, path[1:], f)})
+}
+
+// errorMessage turns an error field into a string field holding its message.
+func errorMessage(f field) field {
+	f.kind = stringField
+	if f.err != nil {
+		f.str = f.err.Error()
+	}
+	return f
+}
+
+// nanoseconds turns a duration field into an integer field of nanoseconds.
+func nanoseconds(f field) field {
+	f.kind = intField
+	return f
+}
+
+// ecsSchema maps an entry to the Elastic Common Schema.
+func ecsSchema(e *entry) {
+	if f, ok := e.take(zerolog.ErrorFieldName); ok {
+		e.nest("error.message", errorMessage(f))
+	}
+	e.rename("stack", "error.stack_trace")
+	if e.event != nil {
+		e.nest("event.action", field{kind: stringField, str: eventName(e.event)})
+	}
+	if f, ok := e.take("runtime"); ok {
+		e.nest("event.duration", nanoseconds(f))
+	}
+	e.nest("log.logger", field{kind: stringField, str: "fx"})
+}
+
+// eventPhase returns the lifecycle phase an event belongs to: "init" for the
+// dependency graph, "start", "rollback" or "stop".
+func eventPhase(event fxevent.Event) string {
+	switch event.(type) {
+	case *fxevent.OnStartExecuting, *fxevent.OnStartExecuted, *fxevent.Started:
+		return "start"
+	case *fxevent.RollingBack, *fxevent.RolledBack:
+		return "rollback"
+	case *fxevent.OnStopExecuting, *fxevent.OnStopExecuted, *fxevent.Stopping, *fxevent.Stopped:
+		return "stop"
+	default:
+		return "init"
+	}
+}
+
+// gcpSeverity maps a zerolog level to a Google Cloud Logging severity.
+func gcpSeverity(lvl zerolog.Level) string {
+	switch lvl {
+	case zerolog.TraceLevel, zerolog.DebugLevel:
+		return "DEBUG"
+	case zerolog.InfoLevel:
+		return "INFO"
+	case zerolog.WarnLevel:
+		return "WARNING"
+	case zerolog.ErrorLevel:
+		return "ERROR"
+	case zerolog.FatalLevel:
+		return "CRITICAL"
+	case zerolog.PanicLevel:
+		return "ALERT"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// gcpSchema adds the Google Cloud Logging severity and labels to an entry.
+func gcpSchema(e *entry) {
+	e.Str("severity", gcpSeverity(e.level))
+	labels := &entry{}
+	if i := slices.IndexFunc(e.fields, func(f field) bool { return f.key == "module" }); i >= 0 {
+		labels.Str("module", e.fields[i].str)
+	}
+	if e.event != nil {
+		labels.Str("phase", eventPhase(e.event))
+	}
+	if len(labels.fields) > 0 {
+		e.Dict("logging.googleapis.com/labels", labels)
+	}
+}
+
+// datadogSchema maps an entry to Datadog's standard attributes. Trace
+// correlation fields, when present, are moved to dd.trace_id and dd.span_id.
+func datadogSchema(e *entry) {
+	e.Str("status", e.level.String())
+	if f, ok := e.take("runtime"); ok {
+		e.nest("duration", nanoseconds(f))
+	}
+	if f, ok := e.take(zerolog.ErrorFieldName); ok {
+		e.nest("error.message", errorMessage(f))
+		if !e.rename("stack", "error.stack") {
+			if st, ok := e.take("stacktrace"); ok {
+				e.nest("error.stack", field{kind: stringField, str: strings.Join(st.strs, "\n")})
+			}
+		}
+	}
+	e.rename("trace_id", "dd.trace_id")
+	e.rename("span_id", "dd.span_id")
+}
+
+// frameLocation parses the file and line from a stack frame of the form
+// "pkg.Func (path/to/file.go:42)". line is zero if the frame has none.
+func frameLocation(frame string) (file string, line int, ok bool) {
+	start, end := strings.LastIndexByte(frame, '('), strings.LastIndexByte(frame, ')')
+	if start < 0 || end < start {
+		return "", 0, false
+	}
+	file = frame[start+1 : end]
+	if i := strings.LastIndexByte(file, ':'); i >= 0 {
+		if n, err := strconv.Atoi(file[i+1:]); err == nil {
+			file, line = file[:i], n
+		}
+	}
+	return file, line, len(file) > 0
+}
+
+// otelSchema maps an entry to OpenTelemetry semantic-convention attribute
+// names, which are flat dotted keys rather than nested objects.
+func otelSchema(e *entry) {
+	for _, key := range []string{"callee", "function", "constructor", "decorator", "name"} {
+		if f, ok := e.take(key); ok {
+			f.key = "code.function"
+			e.fields = append(e.fields, f)
+			break
+		}
+	}
+	if i := slices.IndexFunc(e.fields, func(f field) bool { return f.key == "stacktrace" }); i >= 0 && len(e.fields[i].strs) > 0 {
+		if file, line, ok := frameLocation(e.fields[i].strs[0]); ok {
+			e.Str("code.filepath", file)
+			if line > 0 {
+				e.Int("code.lineno", line)
+			}
+		}
+	}
+	if f, ok := e.take(zerolog.ErrorFieldName); ok {
+		msg := errorMessage(f)
+		msg.key = "exception.message"
+		e.fields = append(e.fields, msg)
+		if f.err != nil {
+			e.Str("exception.type", fmt.Sprintf("%T", f.err))
+		}
+		if st, ok := e.take("stack"); ok {
+			e.Str("exception.stacktrace", st.str)
+		} else if st, ok := e.take("stacktrace"); ok {
+			e.Str("exception.stacktrace", strings.Join(st.strs, "\n"))
+		}
+	}
+}
+
+// compactKeys are the abbreviated keys used by compactSchema.
+var compactKeys = map[string]string{
+	"callee":               "fn",
+	"function":             "fn",
+	"constructor":          "fn",
+	"decorator":            "fn",
+	"name":                 "fn",
+	"caller":               "clr",
+	"runtime":              "rt",
+	"module":               "mod",
+	"type":                 "ty",
+	"signal":               "sig",
+	zerolog.ErrorFieldName: "err",
+}
+
+// compactOptional are the fields dropped by compactSchema.
+var compactOptional = []string{"stacktrace", "moduletrace", "stack", "private", "kind"}
+
+// compactSchema abbreviates the keys of an entry and drops its optional
+// fields, keeping the order of the rest.
+func compactSchema(e *entry) {
+	e.fields = slices.DeleteFunc(e.fields, func(f field) bool {
+		return slices.Contains(compactOptional, f.key)
+	})
+	for i := range e.fields {
+		if key, ok := compactKeys[e.fields[i].key]; ok {
+			e.fields[i].key = key
+		}
+	}
+}
+
+// lokiLabels are the low-cardinality keys kept at the top level by
+// lokiSchema, suitable for use as Loki labels.
+var lokiLabels = []string{"module", "kind", "signal", "shutdown_cause", "private", "slow"}
+
+// lokiSchema keeps a small set of scalar, low-cardinality keys at the top
+// level, adds event and phase, and folds all other fields into a single
+// logfmt-style details string.
+func lokiSchema(e *entry) {
+	var labels, details []field
+	for _, f := range e.fields {
+		if slices.Contains(lokiLabels, f.key) {
+			labels = append(labels, f)
+		} else {
+			details = append(details, f)
+		}
+	}
+	e.fields = labels
+	if e.event != nil {
+		e.Str("event", eventName(e.event)).Str("phase", eventPhase(e.event))
+	}
+	if len(details) > 0 {
+		e.Str("details", detailString(details))
+	}
+}
+
+// detailBuffers holds the buffers detailString renders into, so the
+// details of the records logged in a startup burst reuse them.
+var detailBuffers = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 512)
+		return &b
+	},
+}
+
+// maxDetailBuffer is the capacity above which buffers are not pooled.
+const maxDetailBuffer = 64 << 10
+
+// detailString renders fields as space-separated key=value pairs.
+func detailString(fields []field) string {
+	bp := detailBuffers.Get().(*[]byte)
+	b := appendDetails((*bp)[:0], fields)
+	s := string(b)
+	if cap(b) <= maxDetailBuffer {
+		*bp = b
+		detailBuffers.Put(bp)
+	}
+	return s
+}
+
+// detailValue renders the value of a field as detailString does.
+func detailValue(f field) string {
+	return string(appendDetailValue(nil, f))
+}
+
+// appendDetails appends fields to b as space-separated key=value pairs.
+func appendDetails(b []byte, fields []field) []byte {
+	for i, f := range fields {
+		if i > 0 {
+			b = append(b, ' ')
+		}
+		b = append(b, f.key...)
+		b = append(b, '=')
+		b = appendDetailValue(b, f)
+	}
+	return b
+}
+
+// appendDetailValue appends the value of a field to b, quoting strings as
+// needed. Nested objects are rendered in braces and arrays in brackets.
+func appendDetailValue(b []byte, f field) []byte {
+	switch f.kind {
+	case stringsField:
+		b = append(b, '[')
+		for i, s := range f.strs {
+			if i > 0 {
+				b = append(b, ' ')
+			}
+			b = appendDetailString(b, s)
+		}
+		return append(b, ']')
+	case boolField:
+		return strconv.AppendBool(b, f.num != 0)
+	case intField:
+		return strconv.AppendInt(b, f.num, 10)
+	case floatField:
+		return strconv.AppendFloat(b, f.flt, 'g', -1, 64)
+	case durationField:
+		return append(b, time.Duration(f.num).String()...)
+	case errorField:
+		if f.err == nil {
+			return append(b, `""`...)
+		}
+		return appendDetailString(b, f.err.Error())
+	case objectField:
+		b = append(b, '{')
+		b = appendDetails(b, f.sub)
+		return append(b, '}')
+	case arrayField:
+		b = append(b, '[')
+		for i, obj := range f.sub {
+			if i > 0 {
+				b = append(b, ' ')
+			}
+			b = append(b, '{')
+			b = appendDetails(b, obj.sub)
+			b = append(b, '}')
+		}
+		return append(b, ']')
+	default:
+		return appendDetailString(b, f.str)
+	}
+}
+
+// appendDetailString appends s to b, quoted if it is empty or contains
+// spaces, quotes, equals signs or brackets.
+func appendDetailString(b []byte, s string) []byte {
+	if len(s) == 0 || strings.ContainsAny(s, " \t\n\"={}[]") {
+		return strconv.AppendQuote(b, s)
+	}
+	return append(b, s...)
+}
+
+// splunkSchema returns a schema that wraps an entry in a Splunk HTTP Event
+// Collector envelope: the epoch time in seconds, the sourcetype and the
+// record itself, with its level and message, as the event.
+func splunkSchema(sourcetype string) func(*entry) {
+	return func(e *entry) {
+		event := &entry{}
+		event.Str(zerolog.LevelFieldName, e.level.String())
+		event.fields = append(event.fields, e.fields...)
+		event.Str(zerolog.MessageFieldName, e.msg)
+
+		e.fields = nil
+		e.Float("time", float64(e.l.clock.Now().UnixMilli())/1e3).
+			Str("sourcetype", sourcetype).
+			Dict("event", event)
+		e.envelope = true
+	}
+}
+
+// gelfSchema returns a schema that formats an entry as a GELF 1.1 message
+// for Graylog: the message as short_message, the stack trace, if any, as
+// full_message, the syslog priority as a numeric level and every other
+// field as an additional field prefixed with an underscore.
+func gelfSchema(host string) func(*entry) {
+	return func(e *entry) {
+		stack, hasStack := e.take("stack")
+		fields := e.fields
+		level, _ := strconv.Atoi(journaldPriority(e.level))
+
+		e.fields = nil
+		e.Str("version", "1.1").
+			Str("host", host).
+			Str("short_message", e.msg)
+		if hasStack && len(stack.str) > 0 {
+			e.Str("full_message", stack.str)
+		}
+		e.Float("timestamp", float64(e.l.clock.Now().UnixMilli())/1e3).
+			Int("level", level)
+		for _, f := range fields {
+			e.fields = appendGELFField(e.fields, "_"+gelfKey(f.key), f)
+		}
+		e.envelope = true
+	}
+}
+
+// appendGELFField appends f under key as GELF additional fields, which can
+// only hold strings and numbers: nested objects are flattened into
+// underscore-separated keys, durations become numbers of milliseconds with
+// an _ms suffix, and lists are rendered as detailString does.
+func appendGELFField(fields []field, key string, f field) []field {
+	switch f.kind {
+	case stringField, intField, floatField:
+		f.key = key
+	case errorField:
+		f = errorMessage(f)
+		f.key = key
+	case durationField:
+		f = field{key: key + "_ms", kind: floatField, flt: float64(f.num) / float64(time.Millisecond)}
+	case objectField:
+		for _, sub := range f.sub {
+			fields = appendGELFField(fields, key+"_"+gelfKey(sub.key), sub)
+		}
+		return fields
+	default:
+		f = field{key: key, kind: stringField, str: detailValue(f)}
+	}
+	return append(fields, f)
+}
+
+// gelfKey replaces the characters GELF does not allow in field names, which
+// are limited to letters, digits, underscores, dashes and dots.
+func gelfKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || r == '.' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, key)
+}
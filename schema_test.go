@@ -0,0 +1,288 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithECSFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithECSFields())
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Millisecond})
+	out := buf.String()
+	if !strings.Contains(out, `"event":{"action":"OnStartExecuted","duration":1000000}`) {
+		t.Errorf("Expected event.action and event.duration, got %s", out)
+	}
+	if !strings.Contains(out, `"log":{"logger":"fx"}`) {
+		t.Errorf("Expected log.logger, got %s", out)
+	}
+	if strings.Contains(out, `"runtime"`) {
+		t.Errorf("Expected runtime to be renamed, got %s", out)
+	}
+
+	buf.Reset()
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "f", Err: errors.New("boom"), Trace: "main.go:1"})
+	out = buf.String()
+	if !strings.Contains(out, `"error":{"message":"boom","stack_trace":"main.go:1"}`) {
+		t.Errorf("Expected error.message and error.stack_trace, got %s", out)
+	}
+}
+
+func TestWithECSFields_Summary(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithECSFields(), WithSlowestStartHooks(1))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Runtime: time.Second})
+	buf.Reset()
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, `"action":"Started"`) {
+		t.Errorf("Expected records logged for Started to carry its action, got %s", out)
+	}
+	if !strings.Contains(out, `"runtime":"1s"`) {
+		t.Errorf("Expected nested runtimes to be left alone, got %s", out)
+	}
+}
+
+func TestPresetGCP(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetDefault(), PresetGCP())
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}, ModuleName: "server"})
+	out := buf.String()
+	if !strings.Contains(out, `"severity":"INFO"`) {
+		t.Errorf("Expected INFO severity, got %s", out)
+	}
+	if !strings.Contains(out, `"logging.googleapis.com/labels":{"module":"server","phase":"init"}`) {
+		t.Errorf("Expected module and phase labels, got %s", out)
+	}
+
+	buf.Reset()
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("boom")})
+	out = buf.String()
+	if !strings.Contains(out, `"severity":"ERROR"`) || !strings.Contains(out, `{"phase":"stop"}`) {
+		t.Errorf("Expected ERROR severity in the stop phase, got %s", out)
+	}
+}
+
+func TestPresetDatadog(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetDatadog())
+	logger.LogEvent(&fxevent.Run{Name: "main.New()", Kind: "provide", Runtime: time.Microsecond})
+	out := buf.String()
+	if !strings.Contains(out, `"status":"info"`) || !strings.Contains(out, `"duration":1000`) {
+		t.Errorf("Expected status and duration in nanoseconds, got %s", out)
+	}
+
+	buf.Reset()
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", StackTrace: []string{"a", "b"}, Err: errors.New("boom")})
+	out = buf.String()
+	if !strings.Contains(out, `"error":{"message":"boom","stack":"a\nb"}`) || !strings.Contains(out, `"status":"error"`) {
+		t.Errorf("Expected error.message and error.stack, got %s", out)
+	}
+}
+
+func TestDatadogSchema_TraceCorrelation(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetDatadog())
+	logger.log().Str("trace_id", "1").Str("span_id", "2").Msg("correlated")
+	if !strings.Contains(buf.String(), `"dd":{"trace_id":"1","span_id":"2"}`) {
+		t.Errorf("Expected dd.trace_id and dd.span_id, got %s", buf.String())
+	}
+}
+
+func TestPresetOTel(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetOTel())
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "main.New()",
+		OutputTypeNames: []string{"*main.T"},
+		StackTrace:      []string{"main.main (/app/main.go:12)", "runtime.main (proc.go:283)"},
+	})
+	out := buf.String()
+	if !strings.Contains(out, `"code.function":"main.New()"`) {
+		t.Errorf("Expected code.function, got %s", out)
+	}
+	if !strings.Contains(out, `"code.filepath":"/app/main.go","code.lineno":12`) {
+		t.Errorf("Expected code.filepath and code.lineno, got %s", out)
+	}
+
+	buf.Reset()
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom"), Trace: "main.go:1"})
+	out = buf.String()
+	if !strings.Contains(out, `"exception.message":"boom","exception.type":"*errors.errorString","exception.stacktrace":"main.go:1"`) {
+		t.Errorf("Expected exception attributes, got %s", out)
+	}
+}
+
+func TestPresetCompact(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetCompact())
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "main.New()",
+		OutputTypeNames: []string{"*main.T"},
+		ModuleName:      "server",
+		StackTrace:      []string{"main.main (/app/main.go:12)"},
+		ModuleTrace:     []string{"main.go:10"},
+		Private:         true,
+	})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom"), Trace: "main.go:1"})
+
+	out := buf.String()
+	for _, want := range []string{
+		`"fn":"main.start()","clr":"main.New()","rt":"1ms"`,
+		`"fn":"main.New()"`, `"mod":"server"`, `"ty":"*main.T"`,
+		`"fn":"main.run()"`, `"err":"boom"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"callee", "stacktrace", "moduletrace", "private", `"stack"`, `"error":`} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("Expected no %s, got %s", unwanted, out)
+		}
+	}
+}
+
+func TestFrameLocation(t *testing.T) {
+	tests := []struct {
+		frame string
+		file  string
+		line  int
+		ok    bool
+	}{
+		{"pkg.F (path/to/file.go:42)", "path/to/file.go", 42, true},
+		{"(path/to/file.go)", "path/to/file.go", 0, true},
+		{"pkg.F", "", 0, false},
+	}
+	for _, tt := range tests {
+		file, line, ok := frameLocation(tt.frame)
+		if file != tt.file || line != tt.line || ok != tt.ok {
+			t.Errorf("Expected %q to give %q, %d, %v; got %q, %d, %v", tt.frame, tt.file, tt.line, tt.ok, file, line, ok)
+		}
+	}
+}
+
+func TestWithLokiFields(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithLokiFields())
+	logger.LogEvent(&fxevent.Provided{
+		ConstructorName: "main.New()",
+		OutputTypeNames: []string{"*main.T"},
+		ModuleName:      "server",
+		StackTrace:      []string{"main.main (main.go:1)"},
+	})
+	out := buf.String()
+	if !strings.Contains(out, `"module":"server","event":"Provided","phase":"init"`) {
+		t.Errorf("Expected low-cardinality keys at the top level, got %s", out)
+	}
+	if !strings.Contains(out, `"details":"constructor=main.New() stacktrace=[\"main.main (main.go:1)\"] moduletrace=[] type=*main.T"`) {
+		t.Errorf("Expected other fields folded into details, got %s", out)
+	}
+	if strings.Contains(out, `"stacktrace":`) || strings.Contains(out, `"type":`) {
+		t.Errorf("Expected no high-cardinality top-level fields, got %s", out)
+	}
+}
+
+func TestDetailValue(t *testing.T) {
+	tests := []struct {
+		f    field
+		want string
+	}{
+		{field{kind: stringField, str: "plain"}, "plain"},
+		{field{kind: stringField, str: "a b"}, `"a b"`},
+		{field{kind: stringField}, `""`},
+		{field{kind: boolField, num: 1}, "true"},
+		{field{kind: durationField, num: int64(time.Second)}, "1s"},
+		{field{kind: errorField, err: errors.New("boom")}, "boom"},
+		{field{kind: objectField, sub: []field{{key: "n", kind: intField, num: 2}}}, "{n=2}"},
+	}
+	for _, tt := range tests {
+		if got := detailValue(tt.f); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestPresetSplunk(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetSplunk(""))
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	out := buf.String()
+	if !strings.HasPrefix(out, `{"time":`) {
+		t.Errorf("Expected the record to start with time, got %s", out)
+	}
+	if !strings.Contains(out, `"sourcetype":"_json","event":{"level":"info","function":"main.run()","message":"invoking"}}`) {
+		t.Errorf("Expected a HEC envelope, got %s", out)
+	}
+}
+
+func TestPresetSplunk_RespectsLevel(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).Level(zerolog.ErrorLevel)
+	logger := New(&zl, PresetSplunk("fx"))
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+	if buf.Len() > 0 {
+		t.Errorf("Expected info records to be filtered, got %s", buf.String())
+	}
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), `"sourcetype":"fx","event":{"level":"error","error":"boom"`) {
+		t.Errorf("Expected the error record, got %s", buf.String())
+	}
+}
+
+func TestDetailString_ReusesBuffers(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not stable with the race detector")
+	}
+	fields := []field{
+		{key: "constructor", kind: stringField, str: "main.New()"},
+		{key: "stacktrace", kind: stringsField, strs: []string{"main.main (main.go:1)", "runtime.main (proc.go:250)"}},
+		{key: "moduletrace", kind: stringsField, strs: []string{"main.main (main.go:2)"}},
+	}
+	detailString(fields)
+	if allocs := testing.AllocsPerRun(100, func() { detailString(fields) }); allocs > 1 {
+		t.Errorf("Expected only the result string to be allocated, got %v allocations", allocs)
+	}
+}
+
+func TestPresetGELF(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetGELF("web-1"), WithClock(fixedClock(time.UnixMilli(1700000000123))))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: 1500 * time.Microsecond})
+	out := buf.String()
+	if !strings.HasPrefix(out, `{"version":"1.1","host":"web-1","short_message":"OnStart hook executed","timestamp":1700000000.123,"level":6,`) {
+		t.Errorf("Expected a GELF message, got %s", out)
+	}
+	if !strings.Contains(out, `"_callee":"main.start()","_caller":"main.New()","_runtime_ms":1.5}`) {
+		t.Errorf("Expected prefixed additional fields, got %s", out)
+	}
+	if strings.Contains(out, `"message"`) {
+		t.Errorf("Expected no zerolog message, got %s", out)
+	}
+}
+
+func TestPresetGELF_Failure(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetGELF("web-1"))
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	out := buf.String()
+	if !strings.Contains(out, `"short_message":"start failed"`) || !strings.Contains(out, `"level":3`) || !strings.Contains(out, `"_error":"boom"`) {
+		t.Errorf("Expected the failure, got %s", out)
+	}
+}
+
+func TestGELFField(t *testing.T) {
+	fields := appendGELFField(nil, "_by_module", field{key: "by_module", kind: objectField, sub: []field{
+		{key: "db", kind: objectField, sub: []field{{key: "constructors", kind: intField, num: 2}}},
+	}})
+	fields = appendGELFField(fields, "_types", field{kind: stringsField, strs: []string{"*a", "*b"}})
+	fields = appendGELFField(fields, "_private", field{kind: boolField, num: 1})
+	if len(fields) != 3 || fields[0].key != "_by_module_db_constructors" || fields[0].num != 2 {
+		t.Errorf("Expected flattened objects, got %+v", fields)
+	}
+	if fields[1].str != "[*a *b]" || fields[2].str != "true" {
+		t.Errorf("Expected lists and booleans as strings, got %+v", fields)
+	}
+	if key := gelfKey("logging.googleapis.com/labels"); key != "logging.googleapis.com_labels" {
+		t.Errorf("Expected invalid characters to be replaced, got %s", key)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// shutdownSummary accumulates statistics about the application's shutdown,
+// or the rollback of a failed start.
+type shutdownSummary struct {
+	begin    time.Time // time shutdown began
+	hooks    int       // executed OnStop hooks
+	failed   int       // failed OnStop hooks
+	rollback bool      // whether shutdown is a rollback
+}
+
+// observe records event, received at now, in the summary.
+func (s *shutdownSummary) observe(event fxevent.Event, now time.Time) {
+	switch e := event.(type) {
+	case *fxevent.Stopping:
+		s.start(now)
+	case *fxevent.RollingBack:
+		s.start(now)
+		s.rollback = true
+	case *fxevent.OnStopExecuting:
+		s.start(now)
+	case *fxevent.OnStopExecuted:
+		s.hooks++
+		if e.Err != nil {
+			s.failed++
+		}
+	}
+}
+
+// start records the beginning of shutdown, unless it has already begun.
+func (s *shutdownSummary) start(now time.Time) {
+	if s.begin.IsZero() {
+		s.begin = now
+	}
+}
+
+// fields adds the summary fields to the entry.
+func (s *shutdownSummary) fields(e *entry, now time.Time) *entry {
+	var d time.Duration
+	if !s.begin.IsZero() {
+		d = now.Sub(s.begin)
+	}
+	return e.Int("hooks", s.hooks).
+		Int("failed", s.failed).
+		Dur("duration", d).
+		Bool("rollback", s.rollback)
+}
+
+// logShutdownSummary logs the shutdown summary record and resets it, since
+// a rolled back application may still be stopped.
+func (l *Logger) logShutdownSummary(now time.Time) {
+	event := l.log()
+	if l.shutdown.failed > 0 {
+		event = l.err()
+	}
+	l.shutdown.fields(event, now).Send(KindShutdownSummary)
+	*l.shutdown = shutdownSummary{}
+}
+
+// Shutdown causes stamped by WithShutdownCause.
+const (
+	causeSignal       = "signal"        // a signal was received; see Stopping
+	causeStartFailure = "start_failure" // an OnStart hook failed; see RollingBack
+	causeProgrammatic = "programmatic"  // App.Stop was called directly
+)
+
+// classifyShutdown records why the application is shutting down.
+func (l *Logger) classifyShutdown(event fxevent.Event) {
+	if !l.causes {
+		return
+	}
+	switch event.(type) {
+	case *fxevent.Stopping:
+		l.cause = causeSignal
+	case *fxevent.RollingBack:
+		l.cause = causeStartFailure
+	case *fxevent.Stopped:
+		if len(l.cause) == 0 {
+			l.cause = causeProgrammatic
+		}
+	}
+}
+
+// causeField adds the shutdown_cause field to the entry if shutdown causes
+// are enabled and known.
+func (l *Logger) causeField(event *entry) *entry {
+	if !l.causes || len(l.cause) == 0 {
+		return event
+	}
+	return event.Str("shutdown_cause", l.cause)
+}
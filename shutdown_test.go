@@ -0,0 +1,86 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithShutdownSummary(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithShutdownSummary())
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+	logger.LogEvent(&fxevent.OnStopExecuting{FunctionName: "a", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "a", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuting{FunctionName: "b", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "b", CallerName: "c", Err: errors.New("fail")})
+	logger.LogEvent(&fxevent.Stopped{})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	last := lines[len(lines)-1]
+	for _, want := range []string{`"level":"error"`, `"hooks":2`, `"failed":1`, `"duration":`, `"rollback":false`, `"message":"shutdown summary"`} {
+		if !strings.Contains(last, want) {
+			t.Errorf("Expected summary to contain %s, got %s", want, last)
+		}
+	}
+}
+
+func TestWithShutdownSummary_Rollback(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithShutdownSummary())
+	logger.LogEvent(&fxevent.RollingBack{StartErr: errors.New("fail")})
+	logger.LogEvent(&fxevent.OnStopExecuting{FunctionName: "a", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "a", CallerName: "c"})
+	logger.LogEvent(&fxevent.RolledBack{})
+	want := `{"level":"info","hooks":1,"failed":0,"duration":`
+	if !strings.Contains(buf.String(), want) || !strings.Contains(buf.String(), `"rollback":true,"message":"shutdown summary"`) {
+		t.Errorf("Expected rollback summary, got %s", buf.String())
+	}
+}
+
+func TestWithShutdownCause(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []fxevent.Event
+		want   []string
+	}{
+		{
+			name:   "signal",
+			events: []fxevent.Event{&fxevent.Started{}, &fxevent.Stopping{Signal: os.Interrupt}, &fxevent.Stopped{}},
+			want: []string{
+				`"signal":"INTERRUPT","shutdown_cause":"signal","message":"received signal"`,
+				`"shutdown_cause":"signal","message":"stopped"`,
+			},
+		},
+		{
+			name: "start failure",
+			events: []fxevent.Event{
+				&fxevent.RollingBack{StartErr: errors.New("fail")},
+				&fxevent.RolledBack{Err: errors.New("rollback fail")},
+			},
+			want: []string{`"shutdown_cause":"start_failure","message":"rollback failed"`},
+		},
+		{
+			name:   "programmatic",
+			events: []fxevent.Event{&fxevent.Started{}, &fxevent.Stopped{Err: errors.New("fail")}},
+			want:   []string{`"shutdown_cause":"programmatic","message":"stop failed"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, buf := newTestLoggerWith(WithShutdownCause())
+			for _, e := range tt.events {
+				logger.LogEvent(e)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("Expected %s, got %s", want, buf.String())
+				}
+			}
+		})
+	}
+}
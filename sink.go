@@ -0,0 +1,204 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// EventSink receives the records a Logger produces from fx events, after
+// they have been normalized, filtered and shaped by the Logger's options. It
+// lets other backends, such as files, sockets or test recorders, reuse the
+// handling of each fxevent type without reimplementing it.
+//
+// Write is called with the Logger's lock held, or from its worker goroutine
+// with WithAsync, so it is never called concurrently.
+type EventSink interface {
+	Write(r Record)
+}
+
+// Record is a normalized log record.
+type Record struct {
+	// Level is the record's level.
+	Level zerolog.Level
+	// Message is the record's message, e.g. "provided".
+	Message string
+	// Event is the fx event the record was produced for. It is nil for
+	// records not tied to a single event, such as rate limit summaries.
+	Event fxevent.Event
+	// Time is when the record was written, as read from the Logger's Clock.
+	Time time.Time
+	// Fields are the record's fields in the order they were added.
+	Fields []Field
+}
+
+// Field is a key/value pair of a Record. Value is a string, []string, bool,
+// int64, float64, time.Duration, error, []Field for a nested object, or
+// [][]Field for an array of objects.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// LeveledSink is an EventSink that decides by level which records it
+// receives, such as a bridge to a logging API with levels of its own.
+type LeveledSink interface {
+	EventSink
+	// Enabled reports whether the sink receives records at lvl.
+	Enabled(lvl zerolog.Level) bool
+}
+
+// NewWithSink returns a Logger that writes its records to sink instead of a
+// zerolog logger. Every record is handed to sink; level filtering is left to
+// the sink.
+func NewWithSink(sink EventSink, opts ...Option) *Logger {
+	discard := zerolog.New(io.Discard)
+	l := New(&discard, opts...).(*Logger)
+	l.sink = sink
+	return l
+}
+
+// zerologSink is an EventSink that encodes records with a zerolog logger.
+type zerologSink struct {
+	logger *zerolog.Logger
+}
+
+// NewZerologSink returns an EventSink that writes records to logger, the
+// same way New does.
+func NewZerologSink(logger *zerolog.Logger) EventSink {
+	if logger == nil {
+		nop := zerolog.Nop()
+		logger = &nop
+	}
+	return zerologSink{logger: logger}
+}
+
+// Write encodes r with the zerolog logger.
+func (s zerologSink) Write(r Record) {
+	encodeFields(s.logger.WithLevel(r.Level), recordFields(r.Fields)).Msg(r.Message)
+}
+
+// record converts the entry to a Record.
+func (e *entry) record() Record {
+	return Record{
+		Level:   e.level,
+		Message: e.msg,
+		Event:   e.event,
+		Time:    e.l.clock.Now(),
+		Fields:  sinkFields(e.fields),
+	}
+}
+
+// sinkFields converts fields to their exported form.
+func sinkFields(fields []field) []Field {
+	out := make([]Field, len(fields))
+	for i, f := range fields {
+		out[i] = Field{Key: f.key, Value: sinkValue(f)}
+	}
+	return out
+}
+
+// sinkValue returns the value of a field as documented on Field.
+func sinkValue(f field) any {
+	switch f.kind {
+	case stringsField:
+		return f.strs
+	case boolField:
+		return f.num != 0
+	case intField:
+		return f.num
+	case floatField:
+		return f.flt
+	case durationField:
+		return time.Duration(f.num)
+	case errorField:
+		return f.err
+	case objectField:
+		return sinkFields(f.sub)
+	case arrayField:
+		objs := make([][]Field, len(f.sub))
+		for i, obj := range f.sub {
+			objs[i] = sinkFields(obj.sub)
+		}
+		return objs
+	default:
+		return f.str
+	}
+}
+
+// recordFields converts exported fields back to their internal form.
+// Values of other types are rendered with fmt.Sprint, and nil as a nil
+// error.
+func recordFields(fields []Field) []field {
+	out := make([]field, len(fields))
+	for i, f := range fields {
+		out[i] = recordField(f)
+	}
+	return out
+}
+
+// recordField converts an exported field back to its internal form.
+func recordField(f Field) field {
+	switch v := f.Value.(type) {
+	case string:
+		return field{key: f.Key, kind: stringField, str: v}
+	case []string:
+		return field{key: f.Key, kind: stringsField, strs: v}
+	case bool:
+		var n int64
+		if v {
+			n = 1
+		}
+		return field{key: f.Key, kind: boolField, num: n}
+	case int64:
+		return field{key: f.Key, kind: intField, num: v}
+	case int:
+		return field{key: f.Key, kind: intField, num: int64(v)}
+	case float64:
+		return field{key: f.Key, kind: floatField, flt: v}
+	case time.Duration:
+		return field{key: f.Key, kind: durationField, num: int64(v)}
+	case error:
+		return field{key: f.Key, kind: errorField, err: v}
+	case []Field:
+		return field{key: f.Key, kind: objectField, sub: recordFields(v)}
+	case [][]Field:
+		objs := make([]field, len(v))
+		for i, obj := range v {
+			objs[i] = field{kind: objectField, sub: recordFields(obj)}
+		}
+		return field{key: f.Key, kind: arrayField, sub: objs}
+	case nil:
+		return field{key: f.Key, kind: errorField}
+	default:
+		return field{key: f.Key, kind: stringField, str: fmt.Sprint(v)}
+	}
+}
+
+// teeEnabled reports whether sink receives records at lvl.
+func teeEnabled(sink EventSink, lvl zerolog.Level) bool {
+	ls, ok := sink.(LeveledSink)
+	return !ok || ls.Enabled(lvl)
+}
+
+// writeTees hands the entry to the sinks of WithTeeSink that receive its
+// level.
+func (e *entry) writeTees() {
+	var r *Record
+	for _, sink := range e.l.tees {
+		if !teeEnabled(sink, e.level) {
+			continue
+		}
+		if r == nil {
+			rec := e.record()
+			r = &rec
+		}
+		sink.Write(*r)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// sinkRecorder is an EventSink that keeps every record.
+type sinkRecorder []Record
+
+func (s *sinkRecorder) Write(r Record) {
+	*s = append(*s, r)
+}
+
+func TestNewWithSink(t *testing.T) {
+	var sink sinkRecorder
+	logger := NewWithSink(&sink)
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: time.Second})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+
+	if len(sink) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(sink))
+	}
+	r := sink[0]
+	if r.Message != "OnStart hook executed" || r.Level != zerolog.InfoLevel {
+		t.Errorf("Expected the normalized hook record, got %+v", r)
+	}
+	if _, ok := r.Event.(*fxevent.OnStartExecuted); !ok {
+		t.Errorf("Expected the record to carry its event, got %T", r.Event)
+	}
+	var callee string
+	var runtime time.Duration
+	for _, f := range r.Fields {
+		switch f.Key {
+		case "callee":
+			callee, _ = f.Value.(string)
+		case "runtime":
+			runtime, _ = f.Value.(time.Duration)
+		}
+	}
+	if callee != "main.start" || runtime != time.Second {
+		t.Errorf("Expected typed callee and runtime fields, got %+v", r.Fields)
+	}
+	if sink[1].Level != zerolog.ErrorLevel {
+		t.Errorf("Expected the failed start at error level, got %v", sink[1].Level)
+	}
+}
+
+func TestNewZerologSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf)
+	logger := NewWithSink(NewZerologSink(&zl))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, ModuleName: "server"})
+
+	want := &bytes.Buffer{}
+	wl := zerolog.New(want)
+	New(&wl).LogEvent(&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server"}, ModuleName: "server"})
+	if buf.String() != want.String() {
+		t.Errorf("Expected the zerolog sink to match New, got %s, want %s", buf.String(), want.String())
+	}
+	if !strings.Contains(buf.String(), `"module":"server"`) {
+		t.Errorf("Expected module field, got %s", buf.String())
+	}
+}
+
+// leveledRecorder is a LeveledSink that records what it receives at or
+// above min.
+type leveledRecorder struct {
+	sinkRecorder
+	min zerolog.Level
+}
+
+func (s *leveledRecorder) Enabled(lvl zerolog.Level) bool {
+	return lvl >= s.min
+}
+
+func TestWithTeeSink(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).Level(zerolog.Disabled)
+	var all sinkRecorder
+	errs := &leveledRecorder{min: zerolog.ErrorLevel}
+	logger := New(&zl, WithTeeSink(&all), WithTeeSink(errs))
+	logger.LogEvent(&fxevent.Started{})
+	logger.LogEvent(&fxevent.Stopped{Err: errors.New("boom")})
+
+	if buf.Len() > 0 {
+		t.Errorf("Expected the zerolog logger's level to still apply to it, got %s", buf.String())
+	}
+	if len(all) != 2 {
+		t.Errorf("Expected a sink without levels to receive every record, got %+v", all)
+	}
+	if len(errs.sinkRecorder) != 1 || errs.sinkRecorder[0].Level != zerolog.ErrorLevel {
+		t.Errorf("Expected a leveled sink to receive only the records it enables, got %+v", errs.sinkRecorder)
+	}
+}
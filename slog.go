@@ -0,0 +1,99 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// slogLevel maps a zerolog level to a slog level. Trace is below Debug, and
+// Fatal and Panic above Error, by slog's steps of four.
+func slogLevel(lvl zerolog.Level) slog.Level {
+	switch lvl {
+	case zerolog.TraceLevel:
+		return slog.LevelDebug - 4
+	case zerolog.DebugLevel:
+		return slog.LevelDebug
+	case zerolog.WarnLevel:
+		return slog.LevelWarn
+	case zerolog.ErrorLevel:
+		return slog.LevelError
+	case zerolog.FatalLevel:
+		return slog.LevelError + 4
+	case zerolog.PanicLevel:
+		return slog.LevelError + 8
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// emitSlog forwards the entry to the slog logger, if set and enabled for
+// its level.
+func (e *entry) emitSlog() {
+	if e.l.slog == nil {
+		return
+	}
+	ctx := context.Background()
+	lvl := slogLevel(e.level)
+	if !e.l.slog.Enabled(ctx, lvl) {
+		return
+	}
+	e.l.slog.LogAttrs(ctx, lvl, e.msg, slogAttrs(e.fields)...)
+}
+
+// slogAttrs converts fields to slog attributes. Nested objects become
+// groups.
+func slogAttrs(fields []field) []slog.Attr {
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, f := range fields {
+		attrs = append(attrs, slog.Attr{Key: f.key, Value: slogValue(f)})
+	}
+	return attrs
+}
+
+// slogValue converts the value of a field.
+func slogValue(f field) slog.Value {
+	switch f.kind {
+	case stringsField:
+		return slog.AnyValue(f.strs)
+	case boolField:
+		return slog.BoolValue(f.num != 0)
+	case intField:
+		return slog.Int64Value(f.num)
+	case floatField:
+		return slog.Float64Value(f.flt)
+	case durationField:
+		return slog.DurationValue(time.Duration(f.num))
+	case errorField:
+		return slog.AnyValue(f.err)
+	case objectField:
+		return slog.GroupValue(slogAttrs(f.sub)...)
+	case arrayField:
+		objs := make([]map[string]any, len(f.sub))
+		for i, obj := range f.sub {
+			objs[i] = slogMap(obj.sub)
+		}
+		return slog.AnyValue(objs)
+	default:
+		return slog.StringValue(f.str)
+	}
+}
+
+// slogMap converts the fields of an object in an array, which slog has no
+// value kind for, to a map.
+func slogMap(fields []field) map[string]any {
+	m := make(map[string]any, len(fields))
+	for _, f := range fields {
+		if f.kind == objectField {
+			m[f.key] = slogMap(f.sub)
+		} else {
+			m[f.key] = slogValue(f).Any()
+		}
+	}
+	return m
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithSlog(t *testing.T) {
+	sbuf := &bytes.Buffer{}
+	sl := slog.New(slog.NewJSONHandler(sbuf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger, buf := newTestLoggerWith(WithSlog(sl), WithSlowestStartHooks(1))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start()", CallerName: "main.New()", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Started{})
+
+	out := sbuf.String()
+	for _, want := range []string{
+		`{"level":"INFO","msg":"OnStart hook executed","callee":"main.start()","caller":"main.New()","runtime":1000000}`,
+		`"level":"ERROR","msg":"invoke failed","error":"boom"`,
+		`"hooks":[{"callee":"main.start()","caller":"main.New()","runtime":1000000}]`,
+		`"msg":"slowest OnStart hooks"`,
+		`"msg":"started"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+	if !strings.Contains(buf.String(), `"message":"started"`) {
+		t.Errorf("Expected the zerolog output to be unaffected, got %s", buf.String())
+	}
+}
+
+func TestWithSlog_Levels(t *testing.T) {
+	sbuf := &bytes.Buffer{}
+	sl := slog.New(slog.NewTextHandler(sbuf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	zl := zerolog.New(io.Discard).Level(zerolog.ErrorLevel)
+	logger := New(&zl, WithSlog(sl), WithLogLevel(zerolog.DebugLevel)).(*Logger)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	if out := sbuf.String(); !strings.Contains(out, "level=DEBUG msg=invoking function=main.run()") {
+		t.Errorf("Expected slog's own level to decide, got %s", out)
+	}
+
+	tests := []struct {
+		lvl  zerolog.Level
+		want slog.Level
+	}{
+		{zerolog.TraceLevel, slog.LevelDebug - 4},
+		{zerolog.InfoLevel, slog.LevelInfo},
+		{zerolog.WarnLevel, slog.LevelWarn},
+		{zerolog.FatalLevel, slog.LevelError + 4},
+	}
+	for _, tt := range tests {
+		if got := slogLevel(tt.lvl); got != tt.want {
+			t.Errorf("Expected %s to map to %s, got %s", tt.lvl, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// Stats summarizes the events received by a Logger.
+type Stats struct {
+	// Events counts the events received by type name, e.g. "Provided".
+	Events map[string]int
+	// Errors counts the events carrying an error by type name.
+	Errors map[string]int
+	// HookTime is the total runtime of executed OnStart and OnStop hooks.
+	HookTime time.Duration
+	// First and Last are the times the first and latest events were
+	// received. They are zero if no event has been received.
+	First, Last time.Time
+	// ChannelDrops counts the events not sent to the WithEventChannel
+	// channel because it was full.
+	ChannelDrops int
+	// AsyncDrops counts the records dropped by WithAsync because its
+	// queue was full.
+	AsyncDrops int
+	// WriterDrops counts the records dropped by the non-blocking writer
+	// of NewNonBlocking.
+	WriterDrops int
+}
+
+// statsCollector accumulates Stats. It has its own lock so that Stats can
+// be read while a slow sink is being written to.
+type statsCollector struct {
+	mu    sync.Mutex
+	stats Stats
+}
+
+// observe records event, received at now.
+func (c *statsCollector) observe(event fxevent.Event, now time.Time) {
+	name := eventName(event)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := &c.stats
+	if s.Events == nil {
+		s.Events = make(map[string]int)
+		s.Errors = make(map[string]int)
+		s.First = now
+	}
+	s.Last = now
+	s.Events[name]++
+	if eventError(event) != nil {
+		s.Errors[name]++
+	}
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		s.HookTime += e.Runtime
+	case *fxevent.OnStopExecuted:
+		s.HookTime += e.Runtime
+	}
+}
+
+// dropped records an event dropped by the event channel.
+func (c *statsCollector) dropped() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.ChannelDrops++
+}
+
+// writerDropped records records dropped by the non-blocking writer.
+func (c *statsCollector) writerDropped(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats.WriterDrops += n
+}
+
+// snapshot returns a copy of the accumulated stats.
+func (c *statsCollector) snapshot() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s := c.stats
+	s.Events = maps.Clone(s.Events)
+	s.Errors = maps.Clone(s.Errors)
+	if s.Events == nil {
+		s.Events = make(map[string]int)
+		s.Errors = make(map[string]int)
+	}
+	return s
+}
+
+// Stats returns statistics about the events received so far. It is safe to
+// call concurrently with LogEvent.
+func (l *Logger) Stats() Stats {
+	s := l.stats.snapshot()
+	if l.async != nil {
+		s.AsyncDrops = l.async.dropped()
+	}
+	return s
+}
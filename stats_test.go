@@ -0,0 +1,68 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestLogger_Stats(t *testing.T) {
+	logger, _ := newTestLogger()
+	if s := logger.Stats(); len(s.Events) != 0 || !s.First.IsZero() {
+		t.Fatalf("Expected empty stats, got %+v", s)
+	}
+	before := time.Now()
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "a", Runtime: time.Second})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "b", Runtime: time.Second, Err: errors.New("fail")})
+	logger.LogEvent(&fxevent.OnStopExecuted{FunctionName: "a", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Started{})
+
+	s := logger.Stats()
+	if s.Events["OnStartExecuted"] != 2 || s.Events["OnStopExecuted"] != 1 || s.Events["Started"] != 1 {
+		t.Errorf("Unexpected event counts: %v", s.Events)
+	}
+	if s.Errors["OnStartExecuted"] != 1 || len(s.Errors) != 1 {
+		t.Errorf("Unexpected error counts: %v", s.Errors)
+	}
+	if s.HookTime != 2*time.Second+time.Millisecond {
+		t.Errorf("HookTime = %v", s.HookTime)
+	}
+	if s.First.Before(before) || s.Last.Before(s.First) {
+		t.Errorf("Unexpected timestamps: first %v, last %v", s.First, s.Last)
+	}
+
+	s.Events["Started"] = 100
+	if logger.Stats().Events["Started"] != 1 {
+		t.Error("Expected Stats to return a copy")
+	}
+}
+
+func TestLogger_StatsConcurrent(t *testing.T) {
+	logger, _ := newTestLogger()
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				logger.LogEvent(&fxevent.Invoking{FunctionName: "fn"})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_ = logger.Stats()
+			}
+		}()
+	}
+	wg.Wait()
+	if got := logger.Stats().Events["Invoking"]; got != 400 {
+		t.Errorf("Expected 400 events, got %d", got)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// streamBuffer is the number of records queued for each stream client;
+// records that do not fit are dropped for that client.
+const streamBuffer = 256
+
+// streamRecord is a record published to stream clients.
+type streamRecord struct {
+	id    uint64
+	event string // fx event type name, or "log" for records not tied to one
+	data  []byte // the record as a single line of JSON
+}
+
+// eventStream fans records out to the clients of StreamHandler. It has its
+// own lock, as records are published from the async worker with WithAsync.
+type eventStream struct {
+	mu      sync.Mutex
+	seq     uint64
+	backlog []streamRecord // most recent last
+	max     int
+	clients map[chan streamRecord]struct{}
+	closed  bool
+}
+
+func newEventStream(backlog int) *eventStream {
+	return &eventStream{max: backlog, clients: make(map[chan streamRecord]struct{})}
+}
+
+// publish sends the record to every client and keeps it in the backlog.
+// Clients that are not keeping up miss it.
+func (s *eventStream) publish(event string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.seq++
+	r := streamRecord{id: s.seq, event: event, data: data}
+	if s.max > 0 {
+		if len(s.backlog) == s.max {
+			s.backlog = s.backlog[1:]
+		}
+		s.backlog = append(s.backlog, r)
+	}
+	for ch := range s.clients {
+		select {
+		case ch <- r:
+		default:
+		}
+	}
+}
+
+// subscribe registers a client, returning its channel and the backlog to
+// replay first. The channel is closed once the stream is. It returns nil
+// if the stream is already closed.
+func (s *eventStream) subscribe() (chan streamRecord, []streamRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, nil
+	}
+	ch := make(chan streamRecord, streamBuffer)
+	s.clients[ch] = struct{}{}
+	return ch, append([]streamRecord(nil), s.backlog...)
+}
+
+// unsubscribe removes a client.
+func (s *eventStream) unsubscribe(ch chan streamRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, ch)
+}
+
+// close ends every client's stream.
+func (s *eventStream) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	for ch := range s.clients {
+		close(ch)
+		delete(s.clients, ch)
+	}
+}
+
+// publishStream encodes the finished entry as JSON and publishes it to the
+// stream clients.
+func (e *entry) publishStream() {
+	var buf bytes.Buffer
+	zl := zerolog.New(&buf)
+	now := e.l.clock.Now()
+	if e.envelope {
+		encodeFields(zl.Log().Time(zerolog.TimestampFieldName, now), e.fields).Send()
+	} else {
+		encodeFields(zl.WithLevel(e.level).Time(zerolog.TimestampFieldName, now), e.fields).Msg(e.msg)
+	}
+	if buf.Len() == 0 {
+		return
+	}
+	name := "log"
+	if e.event != nil {
+		name = eventName(e.event)
+	}
+	e.l.stream.publish(name, bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+// StreamHandler returns an http.Handler that streams the Logger's records
+// as they are written, as Server-Sent Events, so that a dashboard or CLI can
+// follow a remote application's lifecycle live. Each record is sent as a
+// single line of JSON, with the fx event type, such as "Started", as the
+// event name and "log" for records not tied to a single event. Clients
+// first receive the WithEventStream backlog, the stream ends when the
+// Logger is closed, and clients that fall behind miss records. Without
+// WithEventStream, the handler responds 404 Not Found.
+func (l *Logger) StreamHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if l.stream == nil {
+			http.Error(w, "records are not streamed, see WithEventStream", http.StatusNotFound)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		ch, backlog := l.stream.subscribe()
+		if ch == nil {
+			http.Error(w, "logger is closed", http.StatusGone)
+			return
+		}
+		defer l.stream.unsubscribe(ch)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		for _, r := range backlog {
+			writeStreamRecord(w, r)
+		}
+		flusher.Flush()
+
+		// Comments keep idle connections from being closed by proxies.
+		keepalive := time.NewTicker(15 * time.Second)
+		defer keepalive.Stop()
+		for {
+			select {
+			case r, ok := <-ch:
+				if !ok {
+					return
+				}
+				writeStreamRecord(w, r)
+			case <-keepalive.C:
+				fmt.Fprint(w, ": keepalive\n\n")
+			case <-req.Context().Done():
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// writeStreamRecord writes r as a Server-Sent Event.
+func writeStreamRecord(w http.ResponseWriter, r streamRecord) {
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", r.id, r.event, r.data)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// readStreamEvent reads the next Server-Sent Event from r.
+func readStreamEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Expected a stream event, got %v", err)
+		}
+		if line == "\n" {
+			return strings.Join(lines, "")
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestStreamHandler(t *testing.T) {
+	logger, _ := newTestLoggerWith(WithEventStream(10))
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"*main.T"}})
+
+	srv := httptest.NewServer(logger.StreamHandler())
+	defer srv.Close()
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected text/event-stream, got %s", ct)
+	}
+	r := bufio.NewReader(resp.Body)
+
+	ev := readStreamEvent(t, r)
+	if !strings.Contains(ev, "id: 1\nevent: Provided\n") || !strings.Contains(ev, `"constructor":"main.New()"`) {
+		t.Errorf("Expected the backlog to be replayed, got %q", ev)
+	}
+
+	logger.LogEvent(&fxevent.Started{})
+	ev = readStreamEvent(t, r)
+	if !strings.Contains(ev, "id: 2\nevent: Started\n") || !strings.Contains(ev, `"message":"started"`) || !strings.Contains(ev, `"time":`) {
+		t.Errorf("Expected the Started record, got %q", ev)
+	}
+
+	logger.Close()
+	if rest, _ := io.ReadAll(r); len(rest) != 0 {
+		t.Errorf("Expected the stream to end on Close, got %q", rest)
+	}
+}
+
+func TestStreamHandler_NotStreamed(t *testing.T) {
+	logger, _ := newTestLoggerWith()
+	rec := httptest.NewRecorder()
+	logger.StreamHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/fx/events", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 without WithEventStream, got %d", rec.Code)
+	}
+}
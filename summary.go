@@ -0,0 +1,139 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// startupSummary accumulates statistics about application startup for the
+// summaries logged at Started.
+type startupSummary struct {
+	begin        time.Time                // time the first event was observed
+	constructors int                      // successful Provided events
+	supplied     int                      // successful Supplied events
+	decorated    int                      // successful Decorated events
+	private      int                      // successful private Provided events
+	modules      map[string]*moduleCounts // by non-empty module name
+	hooks        int                      // executed OnStart hooks
+}
+
+// moduleCounts holds the graph counts of a single module.
+type moduleCounts struct {
+	constructors, supplied, decorated int
+}
+
+// observe records event, received at now, in the summary.
+func (s *startupSummary) observe(event fxevent.Event, now time.Time) {
+	if s.begin.IsZero() {
+		s.begin = now
+	}
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		m := s.module(e.ModuleName)
+		if e.Err == nil {
+			s.constructors++
+			m.constructors++
+			if e.Private {
+				s.private++
+			}
+		}
+	case *fxevent.Supplied:
+		m := s.module(e.ModuleName)
+		if e.Err == nil {
+			s.supplied++
+			m.supplied++
+		}
+	case *fxevent.Decorated:
+		m := s.module(e.ModuleName)
+		if e.Err == nil {
+			s.decorated++
+			m.decorated++
+		}
+	case *fxevent.Run:
+		s.module(e.ModuleName)
+	case *fxevent.Invoking:
+		s.module(e.ModuleName)
+	case *fxevent.OnStartExecuted:
+		s.hooks++
+	}
+}
+
+// module records a module name and returns its counts. Events outside any
+// module are counted against a throwaway value.
+func (s *startupSummary) module(name string) *moduleCounts {
+	if len(name) == 0 {
+		return &moduleCounts{}
+	}
+	if s.modules == nil {
+		s.modules = make(map[string]*moduleCounts)
+	}
+	m, ok := s.modules[name]
+	if !ok {
+		m = &moduleCounts{}
+		s.modules[name] = m
+	}
+	return m
+}
+
+// fields adds the summary fields, as of now, to the entry.
+func (s *startupSummary) fields(e *entry, now time.Time) *entry {
+	return e.Int("constructors", s.constructors).
+		Int("modules", len(s.modules)).
+		Int("hooks", s.hooks).
+		Dur("duration", now.Sub(s.begin))
+}
+
+// constructorFields adds the graph size fields, including a per-module
+// breakdown, to the entry.
+func (s *startupSummary) constructorFields(e *entry) *entry {
+	e = e.Int("constructors", s.constructors).
+		Int("supplied", s.supplied).
+		Int("decorated", s.decorated).
+		Int("private", s.private).
+		Int("modules", len(s.modules))
+	if len(s.modules) == 0 {
+		return e
+	}
+	byModule := &entry{}
+	for _, name := range slices.Sorted(maps.Keys(s.modules)) {
+		m := s.modules[name]
+		byModule.Dict(name, (&entry{}).
+			Int("constructors", m.constructors).
+			Int("supplied", m.supplied).
+			Int("decorated", m.decorated))
+	}
+	return e.Dict("by_module", byModule)
+}
+
+// quieted reports whether event is swallowed by quiet startup mode. Graph and
+// hook events are swallowed unless they carry an error.
+func (l *Logger) quieted(event fxevent.Event) bool {
+	if !l.quiet {
+		return false
+	}
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		return e.Err == nil
+	case *fxevent.Supplied:
+		return e.Err == nil
+	case *fxevent.Decorated:
+		return e.Err == nil
+	case *fxevent.Run:
+		return e.Err == nil
+	case *fxevent.Invoking:
+		return true
+	case *fxevent.OnStartExecuting, *fxevent.OnStopExecuting:
+		return true
+	case *fxevent.OnStartExecuted:
+		return e.Err == nil
+	case *fxevent.OnStopExecuted:
+		return e.Err == nil
+	}
+	return false
+}
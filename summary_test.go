@@ -0,0 +1,69 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithQuietStartup(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithQuietStartup())
+	for _, e := range []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "a", OutputTypeNames: []string{"A"}, ModuleName: "m1"},
+		&fxevent.Provided{ConstructorName: "b", OutputTypeNames: []string{"B"}, ModuleName: "m2"},
+		&fxevent.Supplied{TypeName: "C", ModuleName: "m1"},
+		&fxevent.Run{Name: "a", Kind: "provide"},
+		&fxevent.Invoking{FunctionName: "fn"},
+		&fxevent.OnStartExecuting{FunctionName: "h", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "h", CallerName: "c"},
+		&fxevent.OnStartExecuted{FunctionName: "h2", CallerName: "c", Err: errors.New("hook fail")},
+		&fxevent.Started{},
+	} {
+		logger.LogEvent(e)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected error and summary records only, got %d: %s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], "hook fail") {
+		t.Errorf("Expected hook error to be logged, got %s", lines[0])
+	}
+	for _, want := range []string{`"message":"started"`, `"constructors":2`, `"modules":2`, `"hooks":2`, `"duration":`} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("Expected summary to contain %s, got %s", want, lines[1])
+		}
+	}
+}
+
+func TestWithConstructorSummary(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithConstructorSummary())
+	for _, e := range []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "a", OutputTypeNames: []string{"A"}, ModuleName: "db", Private: true},
+		&fxevent.Provided{ConstructorName: "b", OutputTypeNames: []string{"B"}},
+		&fxevent.Supplied{TypeName: "C", ModuleName: "db"},
+		&fxevent.Decorated{DecoratorName: "d", OutputTypeNames: []string{"A"}, ModuleName: "http"},
+		&fxevent.Provided{ConstructorName: "e", Err: errors.New("fail")},
+		&fxevent.Started{},
+	} {
+		logger.LogEvent(e)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	summary := lines[len(lines)-2]
+	for _, want := range []string{
+		`"constructors":2`, `"supplied":1`, `"decorated":1`, `"private":1`, `"modules":2`,
+		`"by_module":{"db":{"constructors":1,"supplied":1,"decorated":0},"http":{"constructors":0,"supplied":0,"decorated":1}}`,
+		`"message":"constructor summary"`,
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Expected summary to contain %s, got %s", want, summary)
+		}
+	}
+	if !strings.Contains(lines[len(lines)-1], `"message":"started"`) {
+		t.Errorf("Expected started record last, got %s", lines[len(lines)-1])
+	}
+}
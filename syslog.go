@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// syslogLevel returns the level of an entry by event class when syslog
+// severities are enabled. zerolog's syslog writer maps the levels to
+// severities: debug to debug, info to info, warn to warning, error to err and
+// panic to crit.
+func syslogLevel(e *entry) zerolog.Level {
+	if e.event == nil {
+		return e.level
+	}
+	if eventError(e.event) != nil {
+		switch e.event.(type) {
+		case *fxevent.Started, *fxevent.RollingBack, *fxevent.RolledBack:
+			// The application failed to start: crit.
+			return zerolog.PanicLevel
+		default:
+			return zerolog.ErrorLevel
+		}
+	}
+	if e.level > zerolog.InfoLevel {
+		// Warnings, such as slow constructors, keep their level.
+		return e.level
+	}
+	switch e.event.(type) {
+	case *fxevent.Provided, *fxevent.Supplied, *fxevent.Decorated, *fxevent.Replaced, *fxevent.Run:
+		return zerolog.DebugLevel
+	default:
+		return zerolog.InfoLevel
+	}
+}
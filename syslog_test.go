@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithSyslogSeverities(t *testing.T) {
+	tests := []struct {
+		event fxevent.Event
+		level string
+	}{
+		{&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}}, "debug"},
+		{&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c"}, "info"},
+		{&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("boom")}, "error"},
+		{&fxevent.RollingBack{StartErr: errors.New("boom")}, "panic"},
+		{&fxevent.Started{Err: errors.New("boom")}, "panic"},
+		{&fxevent.Stopped{Err: errors.New("boom")}, "error"},
+	}
+	for _, tt := range tests {
+		logger, buf := newTestLoggerWith(WithSyslogSeverities())
+		logger.LogEvent(tt.event)
+		if !strings.Contains(buf.String(), `"level":"`+tt.level+`"`) {
+			t.Errorf("Expected %s at %s level, got %s", eventName(tt.event), tt.level, buf.String())
+		}
+	}
+}
+
+func TestWithSyslogSeverities_KeepsWarnings(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithSyslogSeverities(), WithSlowRunThreshold(time.Millisecond))
+	logger.LogEvent(&fxevent.Run{Name: "main.New()", Kind: "provide", Runtime: time.Second})
+	if !strings.Contains(buf.String(), `"level":"warn"`) {
+		t.Errorf("Expected slow run warning to keep its level, got %s", buf.String())
+	}
+}
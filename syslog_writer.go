@@ -0,0 +1,20 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+//go:build !windows && !binary_log
+
+package fxeventzerolog
+
+import (
+	"github.com/rs/zerolog"
+	"go.uber.org/fx/fxevent"
+)
+
+// NewSyslog creates a Logger that writes to w, such as a *syslog.Writer from
+// log/syslog, through zerolog's syslog writer with WithSyslogSeverities, so
+// each record is sent with the severity of its event class. Options are
+// applied after WithSyslogSeverities.
+func NewSyslog(w zerolog.SyslogWriter, opts ...Option) fxevent.Logger {
+	logger := zerolog.New(zerolog.SyslogLevelWriter(w))
+	return New(&logger, append([]Option{WithSyslogSeverities()}, opts...)...)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+//go:build !windows && !binary_log
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// fakeSyslog records the severity of each message.
+type fakeSyslog struct {
+	severities []string
+}
+
+func (s *fakeSyslog) Write(p []byte) (int, error) { return len(p), nil }
+func (s *fakeSyslog) Debug(string) error          { return s.add("debug") }
+func (s *fakeSyslog) Info(string) error           { return s.add("info") }
+func (s *fakeSyslog) Warning(string) error        { return s.add("warning") }
+func (s *fakeSyslog) Err(string) error            { return s.add("err") }
+func (s *fakeSyslog) Emerg(string) error          { return s.add("emerg") }
+func (s *fakeSyslog) Crit(string) error           { return s.add("crit") }
+
+func (s *fakeSyslog) add(severity string) error {
+	s.severities = append(s.severities, severity)
+	return nil
+}
+
+func TestNewSyslog(t *testing.T) {
+	w := &fakeSyslog{}
+	logger := NewSyslog(w)
+	logger.LogEvent(&fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "f", CallerName: "c", Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Started{Err: errors.New("boom")})
+	logger.LogEvent(&fxevent.Stopping{Signal: os.Interrupt})
+
+	want := []string{"debug", "err", "crit", "info"}
+	if !slices.Equal(w.severities, want) {
+		t.Errorf("Expected severities %v, got %v", want, w.severities)
+	}
+}
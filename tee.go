@@ -0,0 +1,30 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "go.uber.org/fx/fxevent"
+
+// teeLogger fans each event out to several loggers.
+type teeLogger []fxevent.Logger
+
+// LogEvent passes event to each logger in turn.
+func (t teeLogger) LogEvent(event fxevent.Event) {
+	for _, l := range t {
+		l.LogEvent(event)
+	}
+}
+
+// Tee returns an fxevent.Logger that passes each event to every one of
+// loggers, in order, such as this package's Logger alongside fx's console
+// logger. fx accepts a single logger, so this is how they are combined. Nil
+// loggers are skipped.
+func Tee(loggers ...fxevent.Logger) fxevent.Logger {
+	t := make(teeLogger, 0, len(loggers))
+	for _, l := range loggers {
+		if l != nil {
+			t = append(t, l)
+		}
+	}
+	return t
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// eventRecorder records the events it is given.
+type eventRecorder struct {
+	events []fxevent.Event
+}
+
+func (r *eventRecorder) LogEvent(e fxevent.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestTee(t *testing.T) {
+	logger, buf := newTestLoggerWith()
+	rec := &eventRecorder{}
+	tee := Tee(logger, nil, rec)
+
+	tee.LogEvent(&fxevent.Started{})
+	if !strings.Contains(buf.String(), "started") {
+		t.Errorf("Expected the zerolog logger to log the event, got %s", buf.String())
+	}
+	if len(rec.events) != 1 {
+		t.Errorf("Expected the recorder to receive the event, got %d", len(rec.events))
+	}
+}
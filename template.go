@@ -0,0 +1,77 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import "strings"
+
+// messageTemplate is a parsed message template: literal text alternating
+// with field references.
+type messageTemplate []templatePart
+
+// templatePart is either literal text or a reference to the field key.
+type templatePart struct {
+	text string
+	key  string
+}
+
+// parseTemplate parses a template such as "{callee} started in {runtime}".
+// A brace without a matching closing brace is literal text.
+func parseTemplate(tmpl string) messageTemplate {
+	var t messageTemplate
+	for len(tmpl) > 0 {
+		start := strings.IndexByte(tmpl, '{')
+		end := -1
+		if start >= 0 {
+			end = strings.IndexByte(tmpl[start:], '}')
+		}
+		if end < 0 {
+			t = append(t, templatePart{text: tmpl})
+			break
+		}
+		end += start
+		if start > 0 {
+			t = append(t, templatePart{text: tmpl[:start]})
+		}
+		t = append(t, templatePart{key: tmpl[start+1 : end]})
+		tmpl = tmpl[end+1:]
+	}
+	return t
+}
+
+// render renders the template with the values of fields. Strings are
+// rendered as they are and other values as in Loki details; references to
+// missing fields are kept as they are.
+func (t messageTemplate) render(fields []field) string {
+	var b []byte
+	for _, part := range t {
+		if len(part.key) == 0 {
+			b = append(b, part.text...)
+			continue
+		}
+		i := fieldIndex(fields, part.key)
+		switch {
+		case i < 0:
+			b = append(b, '{')
+			b = append(b, part.key...)
+			b = append(b, '}')
+		case fields[i].kind == stringField:
+			b = append(b, fields[i].str...)
+		case fields[i].kind == errorField && fields[i].err != nil:
+			b = append(b, fields[i].err.Error()...)
+		default:
+			b = appendDetailValue(b, fields[i])
+		}
+	}
+	return string(b)
+}
+
+// fieldIndex returns the index of the first field with the key, or -1.
+func fieldIndex(fields []field, key string) int {
+	for i, f := range fields {
+		if f.key == key {
+			return i
+		}
+	}
+	return -1
+}
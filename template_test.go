@@ -0,0 +1,48 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithMessageTemplates(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithMessageTemplates(map[EventKind]string{
+		KindOnStartExecuted: "{callee} started in {runtime}",
+		KindOnStartFailed:   "{callee} failed: {error} {missing}",
+	}))
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: time.Second})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Err: errors.New("boom")})
+
+	out := buf.String()
+	if !strings.Contains(out, `"message":"main.start started in 1s"`) || !strings.Contains(out, `"runtime":"1s"`) {
+		t.Errorf("Expected a rendered message alongside the fields, got %s", out)
+	}
+	if !strings.Contains(out, `"message":"main.start failed: boom {missing}"`) {
+		t.Errorf("Expected missing fields to be kept, got %s", out)
+	}
+}
+
+func TestParseTemplate(t *testing.T) {
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{"plain", "plain"},
+		{"{a}-{b}", "1-x y"},
+		{"open { brace", "open { brace"},
+		{"{a}}", "1}"},
+	}
+	fields := []field{{key: "a", kind: intField, num: 1}, {key: "b", kind: stringField, str: "x y"}}
+	for _, tt := range tests {
+		if got := parseTemplate(tt.tmpl).render(fields); got != tt.want {
+			t.Errorf("Expected %q to render %q, got %q", tt.tmpl, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// timelineSpan is a single OnStart hook in the startup timeline.
+type timelineSpan struct {
+	callee  string
+	caller  string
+	offset  time.Duration // since the first event
+	runtime time.Duration
+}
+
+// startupTimeline records when each OnStart hook ran relative to the first
+// event observed.
+type startupTimeline struct {
+	begin     time.Time
+	executing map[[2]string]time.Time // start time by callee and caller
+	spans     []timelineSpan
+}
+
+func newStartupTimeline() *startupTimeline {
+	return &startupTimeline{executing: make(map[[2]string]time.Time)}
+}
+
+// observe records event, received at now, in the timeline.
+func (t *startupTimeline) observe(event fxevent.Event, now time.Time) {
+	if t.begin.IsZero() {
+		t.begin = now
+	}
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuting:
+		t.executing[[2]string{e.FunctionName, e.CallerName}] = now
+	case *fxevent.OnStartExecuted:
+		key := [2]string{e.FunctionName, e.CallerName}
+		start, ok := t.executing[key]
+		if !ok {
+			start = now.Add(-e.Runtime)
+		}
+		delete(t.executing, key)
+		t.spans = append(t.spans, timelineSpan{
+			callee:  e.FunctionName,
+			caller:  e.CallerName,
+			offset:  start.Sub(t.begin),
+			runtime: e.Runtime,
+		})
+	}
+}
+
+// fields adds the timeline to the entry as an array of objects.
+func (t *startupTimeline) fields(e *entry) *entry {
+	spans := make([]*entry, len(t.spans))
+	for i, s := range t.spans {
+		spans[i] = (&entry{}).Str("callee", s.callee).Str("caller", s.caller).Dur("offset", s.offset).Dur("runtime", s.runtime)
+	}
+	return e.Dicts("timeline", spans)
+}
+
+// traceEvent is a complete event in the Chrome trace event format.
+type traceEvent struct {
+	Name     string            `json:"name"`
+	Category string            `json:"cat"`
+	Phase    string            `json:"ph"`
+	Start    int64             `json:"ts"`  // microseconds
+	Duration int64             `json:"dur"` // microseconds
+	PID      int               `json:"pid"`
+	TID      int               `json:"tid"`
+	Args     map[string]string `json:"args,omitempty"`
+}
+
+// traceEvents returns the timeline in the Chrome trace event JSON format,
+// which can be loaded in chrome://tracing or Perfetto.
+func (t *startupTimeline) traceEvents() ([]byte, error) {
+	events := make([]traceEvent, len(t.spans))
+	for i, s := range t.spans {
+		events[i] = traceEvent{
+			Name:     s.callee,
+			Category: "OnStart",
+			Phase:    "X",
+			Start:    s.offset.Microseconds(),
+			Duration: s.runtime.Microseconds(),
+			PID:      1,
+			TID:      1,
+			Args:     map[string]string{"caller": s.caller},
+		}
+	}
+	return json.Marshal(struct {
+		TraceEvents []traceEvent `json:"traceEvents"`
+	}{events})
+}
+
+// logTimeline logs the startup timeline and writes the trace file as
+// configured.
+func (l *Logger) logTimeline() {
+	if l.timelineLog {
+		l.timeline.fields(l.log()).Send(KindStartupTimeline)
+	}
+	if len(l.traceFile) == 0 {
+		return
+	}
+	b, err := l.timeline.traceEvents()
+	if err == nil {
+		err = os.WriteFile(l.traceFile, b, 0o644)
+	}
+	if err != nil {
+		l.err().Str("path", l.traceFile).Err(err).Send(KindTraceWriteFailed)
+	}
+}
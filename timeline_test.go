@@ -0,0 +1,71 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestStartupTimeline(t *testing.T) {
+	tl := newStartupTimeline()
+	begin := time.Unix(0, 0)
+	tl.observe(&fxevent.Provided{}, begin)
+	tl.observe(&fxevent.OnStartExecuting{FunctionName: "a", CallerName: "c"}, begin.Add(10*time.Millisecond))
+	tl.observe(&fxevent.OnStartExecuted{FunctionName: "a", CallerName: "c", Runtime: 5 * time.Millisecond}, begin.Add(15*time.Millisecond))
+	tl.observe(&fxevent.OnStartExecuted{FunctionName: "b", CallerName: "c", Runtime: 5 * time.Millisecond}, begin.Add(30*time.Millisecond))
+
+	if len(tl.spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(tl.spans))
+	}
+	if s := tl.spans[0]; s.callee != "a" || s.offset != 10*time.Millisecond || s.runtime != 5*time.Millisecond {
+		t.Errorf("Unexpected span: %+v", s)
+	}
+	if s := tl.spans[1]; s.offset != 25*time.Millisecond {
+		t.Errorf("Expected offset inferred from runtime, got %+v", s)
+	}
+
+	b, err := tl.traceEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trace struct {
+		TraceEvents []struct {
+			Name  string `json:"name"`
+			Phase string `json:"ph"`
+			Start int64  `json:"ts"`
+			Dur   int64  `json:"dur"`
+		} `json:"traceEvents"`
+	}
+	if err := json.Unmarshal(b, &trace); err != nil {
+		t.Fatal(err)
+	}
+	if e := trace.TraceEvents[0]; e.Name != "a" || e.Phase != "X" || e.Start != 10000 || e.Dur != 5000 {
+		t.Errorf("Unexpected trace event: %+v", e)
+	}
+}
+
+func TestWithStartupTimeline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	logger, buf := newTestLoggerWith(WithStartupTimeline(), WithStartupTraceFile(path))
+	logger.LogEvent(&fxevent.OnStartExecuting{FunctionName: "a", CallerName: "c"})
+	logger.LogEvent(&fxevent.OnStartExecuted{FunctionName: "a", CallerName: "c", Runtime: time.Millisecond})
+	logger.LogEvent(&fxevent.Started{})
+	if !strings.Contains(buf.String(), `"timeline":[{"callee":"a","caller":"c","offset":`) {
+		t.Errorf("Expected timeline record, got %s", buf.String())
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"traceEvents":[{"name":"a"`) {
+		t.Errorf("Unexpected trace file: %s", b)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+// moduleTiming holds the aggregated runtimes of a single module.
+type moduleTiming struct {
+	startTotal time.Duration // constructor runs and OnStart hooks
+	stopTotal  time.Duration // OnStop hooks
+	runs       int
+	startHooks int
+	stopHooks  int
+}
+
+// moduleTimings aggregates constructor and hook runtimes per fx module.
+// Hook events carry no module, so hooks are attributed to the module of the
+// constructor or invoked function that registered them.
+type moduleTimings struct {
+	owners  map[string]string // function name to module name
+	modules map[string]*moduleTiming
+}
+
+func newModuleTimings() *moduleTimings {
+	return &moduleTimings{
+		owners:  make(map[string]string),
+		modules: make(map[string]*moduleTiming),
+	}
+}
+
+// observe records event in the timings.
+func (t *moduleTimings) observe(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.Provided:
+		t.owners[funcName(e.ConstructorName)] = e.ModuleName
+	case *fxevent.Decorated:
+		t.owners[funcName(e.DecoratorName)] = e.ModuleName
+	case *fxevent.Invoking:
+		t.owners[funcName(e.FunctionName)] = e.ModuleName
+	case *fxevent.Run:
+		m := t.module(e.ModuleName)
+		m.startTotal += e.Runtime
+		m.runs++
+	case *fxevent.OnStartExecuted:
+		m := t.module(t.owners[funcName(e.CallerName)])
+		m.startTotal += e.Runtime
+		m.startHooks++
+	case *fxevent.OnStopExecuted:
+		m := t.module(t.owners[funcName(e.CallerName)])
+		m.stopTotal += e.Runtime
+		m.stopHooks++
+	}
+}
+
+// module returns the timing of the named module, creating it if needed.
+func (t *moduleTimings) module(name string) *moduleTiming {
+	m, ok := t.modules[name]
+	if !ok {
+		m = &moduleTiming{}
+		t.modules[name] = m
+	}
+	return m
+}
+
+// names returns the module names with timings, sorted.
+func (t *moduleTimings) names() []string {
+	return slices.Sorted(maps.Keys(t.modules))
+}
+
+// funcName strips the trailing "()" fx appends to function names, so that
+// constructor names can be matched against hook caller names.
+func funcName(name string) string {
+	return strings.TrimSuffix(name, "()")
+}
+
+// logStartTimings logs a "module start timing" record for each module.
+func (l *Logger) logStartTimings() {
+	for _, name := range l.timings.names() {
+		m := l.timings.modules[name]
+		if m.runs == 0 && m.startHooks == 0 {
+			continue
+		}
+		event := moduleName(l.log(), name)
+		event.Dur("start_total", m.startTotal).Int("runs", m.runs).Int("hooks", m.startHooks).Send(KindModuleStartTiming)
+	}
+}
+
+// logStopTimings logs a "module stop timing" record for each module that ran
+// OnStop hooks.
+func (l *Logger) logStopTimings() {
+	for _, name := range l.timings.names() {
+		m := l.timings.modules[name]
+		if m.stopHooks == 0 {
+			continue
+		}
+		event := moduleName(l.log(), name)
+		event.Dur("stop_total", m.stopTotal).Int("hooks", m.stopHooks).Send(KindModuleStopTiming)
+	}
+}
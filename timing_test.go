@@ -0,0 +1,38 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithModuleTimings(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithModuleTimings())
+	for _, e := range []fxevent.Event{
+		&fxevent.Provided{ConstructorName: "db.New()", OutputTypeNames: []string{"*db.DB"}, ModuleName: "db"},
+		&fxevent.Invoking{FunctionName: "main.Register()"},
+		&fxevent.Run{Name: "db.New()", Kind: "provide", ModuleName: "db", Runtime: 40 * time.Millisecond},
+		&fxevent.OnStartExecuted{FunctionName: "db.New.func1()", CallerName: "db.New", Runtime: 800 * time.Millisecond},
+		&fxevent.OnStartExecuted{FunctionName: "main.Register.func1()", CallerName: "main.Register", Runtime: time.Millisecond},
+		&fxevent.Started{},
+		&fxevent.OnStopExecuted{FunctionName: "db.New.func2()", CallerName: "db.New", Runtime: 20 * time.Millisecond},
+		&fxevent.Stopped{},
+	} {
+		logger.LogEvent(e)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`{"level":"info","start_total":"1ms","runs":0,"hooks":1,"message":"module start timing"}`,
+		`{"level":"info","module":"db","start_total":"840ms","runs":1,"hooks":1,"message":"module start timing"}`,
+		`{"level":"info","module":"db","stop_total":"20ms","hooks":1,"message":"module stop timing"}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s, got %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+// traceFields adds the trace_id and span_id fields to an entry, if known.
+func traceFields(e *entry) {
+	traceID, spanID := e.l.traceIDs()
+	if len(traceID) > 0 {
+		e.Str("trace_id", traceID)
+	}
+	if len(spanID) > 0 {
+		e.Str("span_id", spanID)
+	}
+}
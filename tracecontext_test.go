@@ -0,0 +1,43 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithTraceContextFunc(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithTraceContextFunc(func() (string, string) {
+		return "abc", "def"
+	}))
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, `"trace_id":"abc","span_id":"def"`) {
+		t.Errorf("Expected trace_id and span_id, got %s", out)
+	}
+}
+
+func TestWithTraceContextFunc_Empty(t *testing.T) {
+	logger, buf := newTestLoggerWith(WithTraceContextFunc(func() (string, string) {
+		return "", ""
+	}))
+	logger.LogEvent(&fxevent.Started{})
+	if strings.Contains(buf.String(), "trace_id") {
+		t.Errorf("Expected no trace fields without IDs, got %s", buf.String())
+	}
+}
+
+func TestWithTraceContextFunc_Datadog(t *testing.T) {
+	logger, buf := newTestLoggerWith(PresetDatadog(), WithTraceContextFunc(func() (string, string) {
+		return "123", ""
+	}))
+	logger.LogEvent(&fxevent.Started{})
+	out := buf.String()
+	if !strings.Contains(out, `"dd":{"trace_id":"123"}`) || strings.Contains(out, "span_id") {
+		t.Errorf("Expected dd.trace_id only, got %s", out)
+	}
+}
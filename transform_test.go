@@ -0,0 +1,47 @@
+// Copyright (c) 2025 Amari Robinson
+// SPDX-License-Identifier: MIT
+
+package fxeventzerolog
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/fx/fxevent"
+)
+
+func TestWithTransform(t *testing.T) {
+	scrub := func(event fxevent.Event) fxevent.Event {
+		if e, ok := event.(*fxevent.Provided); ok {
+			c := *e
+			c.ModuleName = strings.ReplaceAll(c.ModuleName, "secret", "***")
+			return &c
+		}
+		return event
+	}
+	drop := func(event fxevent.Event) fxevent.Event {
+		if _, ok := event.(*fxevent.Invoking); ok {
+			return nil
+		}
+		return event
+	}
+	logger, buf := newTestLoggerWith(WithTransform(scrub), WithTransform(drop))
+
+	original := &fxevent.Provided{ConstructorName: "main.New()", OutputTypeNames: []string{"T"}, ModuleName: "secret-module"}
+	logger.LogEvent(original)
+	logger.LogEvent(&fxevent.Invoking{FunctionName: "main.run()"})
+
+	out := buf.String()
+	if !strings.Contains(out, `"module":"***-module"`) {
+		t.Errorf("Expected the transformed module name, got %s", out)
+	}
+	if original.ModuleName != "secret-module" {
+		t.Error("Expected the original event to be untouched")
+	}
+	if strings.Contains(out, "invoking") {
+		t.Errorf("Expected the dropped event not to be logged, got %s", out)
+	}
+	if n := logger.Stats().Events["Invoking"]; n != 0 {
+		t.Errorf("Expected the dropped event not to be counted, got %d", n)
+	}
+}
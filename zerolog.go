@@ -4,7 +4,16 @@
 package fxeventzerolog
 
 import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rs/zerolog"
 	"go.uber.org/fx/fxevent"
@@ -16,142 +25,595 @@ type Logger struct {
 	inner    *zerolog.Logger // underlying zerolog logger
 	logLvl   zerolog.Level   // log level for non-error events (default: zerolog.InfoLevel)
 	errorLvl zerolog.Level   // log level for error events
+
+	skipFxInternals bool                                                 // suppress Provided records for fx's own constructors
+	decorations     bool                                                 // log Decorated records
+	suppressed      []*regexp.Regexp                                     // type and constructor names to suppress
+	redacted        []*regexp.Regexp                                     // type and constructor names to redact
+	allowedFields   map[string]struct{}                                  // the only fields written, if set
+	deniedFields    map[string]struct{}                                  // fields never written
+	minimal         bool                                                 // keep only scalar fields, with short names
+	hasher          *nameHasher                                          // replaces function names with hashes, if set
+	dedup           bool                                                 // collapse identical consecutive records
+	limiter         *rateLimiter                                         // per event type rate limit, if any
+	graphSampler    zerolog.Sampler                                      // sampler for Provided and Run events, if any
+	quiet           bool                                                 // swallow graph and hook events, summarizing them at Started
+	graphSummary    bool                                                 // log a constructor summary record at Started
+	demote          bool                                                 // log graph events at debug level once started
+	executedOnly    bool                                                 // skip OnStartExecuting and OnStopExecuting
+	minRuntime      time.Duration                                        // successful hooks and runs faster than this are skipped
+	slowRun         time.Duration                                        // runs slower than this are logged at warn level
+	moduleLevels    map[string]zerolog.Level                             // log level overrides by module name
+	noTraces        bool                                                 // omit stack and module traces
+	messages        map[EventKind]string                                 // message overrides by kind of record
+	templates       map[EventKind]messageTemplate                        // message templates by kind of record
+	catalog         MessageCatalog                                       // localized messages, if set
+	limits          *fieldLimits                                         // caps on field sizes, if any
+	errorTraces     bool                                                 // only add stack and module traces to failed events
+	graphLog        bool                                                 // log the dependency graph at Started
+	graphFile       string                                               // write the dependency graph to this file at Started
+	timelineLog     bool                                                 // log the startup timeline at Started
+	traceFile       string                                               // write the startup timeline as a Chrome trace to this file at Started
+	resources       bool                                                 // attach a resource snapshot to Started and Stopped
+	causes          bool                                                 // stamp shutdown_cause on shutdown records
+	tees            []EventSink                                          // also hand records to these sinks
+	slog            *slog.Logger                                         // also forward records to slog, if set
+	schema          func(*entry)                                         // rewrites fields into a backend's conventions, if set
+	syslog          bool                                                 // map levels to syslog severities by event class
+	journald        bool                                                 // add journald PRIORITY fields
+	identifier      string                                               // journald SYSLOG_IDENTIFIER, if any
+	onFailure       []func(fxevent.Event, error)                         // called on lifecycle failures
+	traceIDs        func() (traceID, spanID string)                      // trace correlation, if set
+	audit           *zerolog.Logger                                      // audit trail of lifecycle milestones, if set
+	chain           *auditChain                                          // rolling hash of the audit trail, if set
+	cloud           *cloudEmitter                                        // publishes milestones as CloudEvents, if set
+	fallback        fxevent.Logger                                       // receives events that produce no record, if set
+	transforms      []func(fxevent.Event) fxevent.Event                  // applied to each event before it is handled
+	onLogged        []func(fxevent.Event, zerolog.Level)                 // called after each record is written
+	overrides       map[reflect.Type]func(*zerolog.Event, fxevent.Event) // custom handlers by event type
+	events          chan<- fxevent.Event                                 // mirror of every event, if set
+	stream          *eventStream                                         // streams records to StreamHandler clients, if set
+	async           *asyncWriter                                         // writes records on a worker goroutine, if set
+	closer          io.Closer                                            // closed by Close, if set
+	unreported      atomic.Int64                                         // records dropped by the non-blocking writer, not yet reported
+	consoleOut      bool                                                 // the zerolog logger writes through consoleWriter
+	emoji           bool                                                 // prefix console milestone messages with emoji
+	consoleDur      func(time.Duration) string                           // formats durations in console records, if set
+	console         *zerolog.Logger                                      // pretty copy of every record, if set
+	routes          []levelRoute                                         // per-level outputs, highest minimum level first
+	clock           Clock                                                // source of the current time
+	sink            EventSink                                            // replaces the zerolog logger as the record output, if set
+	postStart       func(*zerolog.Logger) *zerolog.Logger                // returns the logger to switch to once started, if set
+
+	off     entry             // shared disabled entry
+	stats   statsCollector    // event statistics, with its own lock
+	names   nameCache         // processed names, with its own lock
+	signals *lifecycleSignals // Ready and Done channels
+
+	mu        sync.Mutex           // serializes LogEvent and guards the fields below
+	event     fxevent.Event        // event being logged
+	startup   *startupSummary      // startup statistics, if a summary is enabled
+	deps      *depGraph            // dependency graph, if accumulated
+	providers map[string]string    // first constructor of each type, if checking duplicates
+	timings   *moduleTimings       // runtimes per module, if reported
+	timeline  *startupTimeline     // OnStart hook timeline, if reported
+	order     *orderValidator      // lifecycle state machine, if validating order
+	shutdown  *shutdownSummary     // shutdown statistics, if summarized
+	cause     string               // why the application is shutting down, if known
+	started   bool                 // whether Started has been logged successfully
+	base      *optionSettings      // settings given as options, once a config is applied
+	slowStart *slowestHooks        // slowest OnStart hooks, if reported
+	slowStop  *slowestHooks        // slowest OnStop hooks, if reported
+	hookDist  *runtimeDistribution // hook runtimes, if their distribution is reported
+	debug     *debugState          // lifecycle state for DebugHandler, if recorded
+	pending   *entry               // last record, held back while deduplicating
+	repeats   int                  // number of times pending was seen
+	emitted   int                  // number of records emitted so far
+	logged    []loggedRecord       // records written, to report to onLogged
 }
 
 var _ fxevent.Logger = (*Logger)(nil)
 
-// New creates a new Logger that writes to the provided zerolog.Logger.
-func New(logger *zerolog.Logger) fxevent.Logger {
+// New creates a new Logger that writes to the provided zerolog.Logger,
+// configured by the given options.
+func New(logger *zerolog.Logger, opts ...Option) fxevent.Logger {
 	if logger == nil {
 		nop := zerolog.Nop()
 		logger = &nop
 	}
 
-	return &Logger{
+	l := &Logger{
 		inner:    logger,
 		logLvl:   zerolog.InfoLevel,
 		errorLvl: zerolog.ErrorLevel,
+		signals:  newLifecycleSignals(),
+		clock:    systemClock{},
+	}
+	l.off = entry{l: l, disabled: true}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.async != nil {
+		go l.async.run()
+	}
+
+	return l
+}
+
+// NewDiscard returns a Logger configured by opts that formats every record
+// as New does but discards the output. It is useful to measure the overhead
+// of lifecycle logging, or to keep the Logger's introspection without its
+// output.
+func NewDiscard(opts ...Option) *Logger {
+	logger := zerolog.New(io.Discard)
+	return New(&logger, opts...).(*Logger)
+}
+
+// err returns an entry at the configured error level, or Error level by default.
+func (l *Logger) err() *entry {
+	return l.at(l.errorLvl)
+}
+
+// log returns an entry at the configured log level, or Info level by default.
+func (l *Logger) log() *entry {
+	return l.at(l.logLvl)
+}
+
+// writes reports whether logger writes records at lvl.
+func writes(logger *zerolog.Logger, lvl zerolog.Level) bool {
+	return lvl >= logger.GetLevel() && lvl >= zerolog.GlobalLevel()
+}
+
+// at returns an entry at the given level for the event being logged, or a
+// disabled entry if no output would write it.
+func (l *Logger) at(lvl zerolog.Level) *entry {
+	if !l.wants(lvl) {
+		return &l.off
+	}
+	return newEntry(l, lvl, l.event)
+}
+
+// detached returns an entry at the given level for a record of the Logger's
+// own that is not tied to an fx event, such as a failure to reload its
+// config, written from outside LogEvent. It must be called with l.mu held.
+func (l *Logger) detached(lvl zerolog.Level) *entry {
+	l.event = nil
+	return l.at(lvl)
+}
+
+// wants reports whether a record at lvl may be written by the zerolog
+// logger, the console, the sink, the slog bridge or one of the sinks of
+// WithTeeSink, each of which decides by its own level. With
+// WithSyslogSeverities, the level is only known once the record is finished.
+func (l *Logger) wants(lvl zerolog.Level) bool {
+	return l.syslog || l.sink != nil || writes(l.inner, lvl) ||
+		(l.console != nil && writes(l.console, lvl)) ||
+		(l.slog != nil && l.slog.Enabled(context.Background(), slogLevel(lvl))) ||
+		slices.ContainsFunc(l.tees, func(s EventSink) bool { return teeEnabled(s, lvl) })
+}
+
+// graph returns an entry for a successful dependency graph event in the given
+// module. A WithModuleLevel override for the module takes precedence; failing
+// that, once the application has started these are logged at debug level if
+// demotion is enabled.
+func (l *Logger) graph(module string, trace []string) *entry {
+	if lvl, ok := l.moduleLevel(module, trace); ok {
+		return l.at(lvl)
+	}
+	if l.demote && l.started {
+		return l.at(zerolog.DebugLevel)
+	}
+	return l.log()
+}
+
+// moduleLevel returns the level override for an event in the named module.
+// If the module itself has no override, its ancestors in trace are consulted,
+// innermost first.
+func (l *Logger) moduleLevel(module string, trace []string) (zerolog.Level, bool) {
+	if len(l.moduleLevels) == 0 {
+		return zerolog.NoLevel, false
+	}
+	if lvl, ok := l.moduleLevels[module]; ok && len(module) > 0 {
+		return lvl, true
+	}
+	for _, t := range trace {
+		name, ok := l.names.traceModule(t)
+		if !ok {
+			continue
+		}
+		if lvl, ok := l.moduleLevels[name]; ok {
+			return lvl, true
+		}
+	}
+	return zerolog.NoLevel, false
+}
+
+// traceModules returns the names of the modules in an fx module trace,
+// innermost first. Module entries have the form "function (file:line) (name)";
+// other entries, such as the constructor's own location, are skipped.
+func traceModules(trace []string) []string {
+	var names []string
+	for _, t := range trace {
+		if name, ok := traceModule(t); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// traceModule returns the name of the module of an fx module trace entry, and
+// false if the entry is not a module entry.
+func traceModule(entry string) (string, bool) {
+	i := strings.LastIndex(entry, ") (")
+	if i < 0 || !strings.HasSuffix(entry, ")") {
+		return "", false
 	}
+	return entry[i+3 : len(entry)-1], true
 }
 
-// err returns a zerolog event at the configured error level, or Error level by default.
-func (l *Logger) err() *zerolog.Event {
-	return l.inner.WithLevel(l.errorLvl)
+// slowEnough reports whether a successful hook or run with the given runtime
+// meets the WithMinHookRuntime threshold.
+func (l *Logger) slowEnough(runtime time.Duration) bool {
+	return l.minRuntime <= 0 || runtime > l.minRuntime
 }
 
-// log returns a zerolog event at the configured log level, or Info level by default.
-func (l *Logger) log() *zerolog.Event {
-	return l.inner.WithLevel(l.logLvl)
+// emit writes a completed entry, holding it back first if deduplication is
+// enabled so that identical consecutive entries collapse into one.
+func (l *Logger) emit(e *entry) {
+	l.emitted++
+	if e.disabled {
+		return
+	}
+	if !l.dedup {
+		e.write()
+		return
+	}
+	if l.pending != nil && l.pending.sameAs(e) {
+		l.repeats++
+		e.release()
+		return
+	}
+	l.flush()
+	l.pending, l.repeats = e, 1
+}
+
+// flush writes the entry held back by deduplication, if any.
+func (l *Logger) flush() {
+	if l.pending == nil {
+		return
+	}
+	if l.repeats > 1 {
+		l.pending.Int("repeat_count", l.repeats)
+	}
+	l.pending.write()
+	l.pending = nil
 }
 
 // LogEvent logs the given Fx event to the underlying zerolog logger.
 // It handles all standard fxevent.Event types and logs relevant fields for each.
 func (l *Logger) LogEvent(event fxevent.Event) {
+	for _, t := range l.transforms {
+		if event = t(event); event == nil {
+			return
+		}
+	}
+
+	now := l.clock.Now()
+	l.stats.observe(event, now)
+	l.mirror(event)
+	defer l.signals.observe(event)
+	// Failure handlers run once the event is logged and the lock released,
+	// so they may block or use the Logger.
+	defer l.notifyFailure(event)
+	defer l.sendCloudEvent(event)
+	var unhandled bool
+	defer func() {
+		if unhandled {
+			l.fallback.LogEvent(event)
+		}
+	}()
+
+	var logged []loggedRecord
+	defer func() { l.notifyLogged(logged) }()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	defer func() { logged, l.logged = l.logged, nil }()
+
+	l.reportDropped()
+	l.event = event
+	if l.startup != nil {
+		l.startup.observe(event, now)
+	}
+	if l.deps != nil {
+		l.deps.observe(event)
+	}
+	if l.timings != nil {
+		l.timings.observe(event)
+	}
+	if l.timeline != nil {
+		l.timeline.observe(event, now)
+	}
+	if l.debug != nil {
+		l.debug.observe(event, now)
+	}
+	l.observeHooks(event)
+	if l.shutdown != nil {
+		l.shutdown.observe(event, now)
+	}
+	if e, ok := event.(*fxevent.Provided); ok {
+		l.checkDuplicates(e)
+	}
+	l.validateOrder(event)
+	l.classifyShutdown(event)
+	if l.audit != nil {
+		l.logAudit(event)
+	}
+	emitted := l.emitted
+	if !l.quieted(event) && l.sampled(event) && l.allowEvent(event) {
+		l.logEvent(event)
+	}
+	unhandled = l.fallback != nil && l.emitted == emitted
+	if l.shutdown != nil {
+		switch event.(type) {
+		case *fxevent.Stopped, *fxevent.RolledBack:
+			l.logShutdownSummary(now)
+		}
+	}
+
+	if e, ok := event.(*fxevent.Started); ok && e.Err == nil {
+		l.started = true
+	}
+
+	switch event.(type) {
+	case *fxevent.Started, *fxevent.Stopped, *fxevent.RolledBack:
+		// Lifecycle milestones may be followed by a long quiet period, so
+		// don't leave anything held back.
+		l.summarizeAllDropped()
+		l.flush()
+	}
+	if l.started {
+		l.switchAfterStart()
+	}
+}
+
+// logEvent converts event into entries and emits them.
+func (l *Logger) logEvent(event fxevent.Event) {
+	if l.override(event) {
+		return
+	}
 	switch e := event.(type) {
 	case *fxevent.OnStartExecuting:
-		l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Msg("OnStart hook executing")
+		if l.executedOnly {
+			return
+		}
+		l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Send(KindOnStartExecuting)
 	case *fxevent.OnStartExecuted:
 		if e.Err != nil {
-			l.err().Str("callee", e.FunctionName).Str("caller", e.CallerName).Err(e.Err).Msg("OnStart hook failed")
-		} else {
-			l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Str("runtime", e.Runtime.String()).Msg("OnStart hook executed")
+			l.err().Str("callee", e.FunctionName).Str("caller", e.CallerName).Err(e.Err).Send(KindOnStartFailed)
+		} else if l.slowEnough(e.Runtime) {
+			l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Dur("runtime", e.Runtime).Send(KindOnStartExecuted)
 		}
 	case *fxevent.OnStopExecuting:
-		l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Msg("OnStop hook executing")
+		if l.executedOnly {
+			return
+		}
+		l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Send(KindOnStopExecuting)
 	case *fxevent.OnStopExecuted:
 		if e.Err != nil {
-			l.err().Str("callee", e.FunctionName).Str("caller", e.CallerName).Err(e.Err).Msg("OnStop hook failed")
-		} else {
-			l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Str("runtime", e.Runtime.String()).Msg("OnStop hook executed")
+			l.err().Str("callee", e.FunctionName).Str("caller", e.CallerName).Err(e.Err).Send(KindOnStopFailed)
+		} else if l.slowEnough(e.Runtime) {
+			l.log().Str("callee", e.FunctionName).Str("caller", e.CallerName).Dur("runtime", e.Runtime).Send(KindOnStopExecuted)
 		}
 	case *fxevent.Supplied:
-		var event *zerolog.Event
+		if e.Err == nil && l.isSuppressed(e.TypeName) {
+			return
+		}
+		var event *entry
 		if e.Err != nil {
 			event = l.err()
 		} else {
-			event = l.log()
+			event = l.graph(e.ModuleName, e.ModuleTrace)
 		}
 
-		event = event.Str("type", e.TypeName).Strs("stacktrace", e.StackTrace).Strs("moduletrace", e.ModuleTrace)
+		event = l.traces(event.Str("type", e.TypeName), e.StackTrace, e.ModuleTrace)
 		event = moduleName(event, e.ModuleName)
 
 		if e.Err != nil {
-			event.Err(e.Err).Msg("error encountered while applying options")
+			event.Err(e.Err).Send(KindSupplyFailed)
 		} else {
-			event.Msg("supplied")
+			event.Send(KindSupplied)
 		}
 	case *fxevent.Provided:
-		for _, rtype := range e.OutputTypeNames {
-			event := l.log().Str("constructor", e.ConstructorName).Strs("stacktrace", e.StackTrace).Strs("moduletrace", e.ModuleTrace)
+		if l.skipFxInternals && e.Err == nil && isFxInternal(e.ConstructorName) {
+			return
+		}
+		if len(e.OutputTypeNames) > 0 && !l.isSuppressed(e.ConstructorName) {
+			// The fields shared by every output type are built once, and
+			// copied for all but the last type.
+			shared := l.graph(e.ModuleName, e.ModuleTrace).Str("constructor", e.ConstructorName)
+			shared = l.traces(shared, e.StackTrace, e.ModuleTrace)
+			shared = moduleName(shared, e.ModuleName)
+			for i, rtype := range e.OutputTypeNames {
+				if l.isSuppressed(rtype) {
+					continue
+				}
+				event := shared
+				if i < len(e.OutputTypeNames)-1 {
+					event = shared.clone()
+				}
+				event = event.Str("type", rtype)
+				event = maybeBool(event, "private", e.Private)
+				event.Send(KindProvided)
+			}
+		}
+		if e.Err != nil {
+			event := l.traces(l.err(), e.StackTrace, e.ModuleTrace)
 			event = moduleName(event, e.ModuleName)
-			event = event.Str("type", rtype)
-			event = maybeBool(event, "private", e.Private)
-			event.Msg("provided")
+			event.Err(e.Err).Send(KindProvideFailed)
+		}
+	case *fxevent.Decorated:
+		if !l.decorations {
+			break
+		}
+		if len(e.OutputTypeNames) > 0 && !l.isSuppressed(e.DecoratorName) {
+			shared := l.graph(e.ModuleName, e.ModuleTrace).Str("decorator", e.DecoratorName)
+			shared = l.traces(shared, e.StackTrace, e.ModuleTrace)
+			shared = moduleName(shared, e.ModuleName)
+			for i, rtype := range e.OutputTypeNames {
+				if l.isSuppressed(rtype) {
+					continue
+				}
+				event := shared
+				if i < len(e.OutputTypeNames)-1 {
+					event = shared.clone()
+				}
+				event.Str("type", rtype).Send(KindDecorated)
+			}
 		}
 		if e.Err != nil {
-			event := l.err().Strs("stacktrace", e.StackTrace).Strs("moduletrace", e.ModuleTrace)
+			event := l.traces(l.err(), e.StackTrace, e.ModuleTrace)
 			event = moduleName(event, e.ModuleName)
-			event.Err(e.Err).Msg("error encountered while applying options")
+			event.Err(e.Err).Send(KindDecorateFailed)
 		}
 	case *fxevent.Run:
 		if e.Err != nil {
 			event := l.err().Str("name", e.Name).Str("kind", e.Kind)
 			event = moduleName(event, e.ModuleName)
-			event.Msg("error returned")
-		} else {
-			event := l.log().Str("name", e.Name).Str("kind", e.Kind).Str("runtime", e.Runtime.String())
+			event.Send(KindRunFailed)
+		} else if l.slowRun > 0 && e.Runtime > l.slowRun {
+			event := l.at(zerolog.WarnLevel).Str("name", e.Name).Str("kind", e.Kind).Dur("runtime", e.Runtime)
+			event = moduleName(event, e.ModuleName)
+			event.Bool("slow", true).Send(KindRun)
+		} else if l.slowEnough(e.Runtime) {
+			event := l.graph(e.ModuleName, nil).Str("name", e.Name).Str("kind", e.Kind).Dur("runtime", e.Runtime)
 			event = moduleName(event, e.ModuleName)
-			event.Msg("run")
+			event.Send(KindRun)
 		}
 	case *fxevent.Invoking:
-		event := l.log().Str("function", e.FunctionName)
+		event := l.graph(e.ModuleName, nil).Str("function", e.FunctionName)
 		event = moduleName(event, e.ModuleName)
-		event.Msg("invoking")
+		event.Send(KindInvoking)
 	case *fxevent.Invoked:
 		if e.Err != nil {
-			event := l.err().Err(e.Err).Str("stack", e.Trace).Str("function", e.FunctionName)
+			event := l.err().Err(e.Err)
+			if !l.noTraces {
+				event = event.Str("stack", e.Trace)
+			}
+			event = event.Str("function", e.FunctionName)
 			event = moduleName(event, e.ModuleName)
-			event.Msg("invoke failed")
+			event.Send(KindInvokeFailed)
 		}
 	case *fxevent.Stopping:
-		l.log().Str("signal", strings.ToUpper(e.Signal.String())).Msg("received signal")
+		l.causeField(l.log().Str("signal", strings.ToUpper(e.Signal.String()))).Send(KindStopping)
 	case *fxevent.Stopped:
+		if l.slowStop != nil {
+			l.slowStop.fields(l.log()).Send(KindSlowestStopHooks)
+		}
+		if l.timings != nil {
+			l.logStopTimings()
+		}
+		if l.hookDist != nil {
+			l.hookDist.fields(l.log()).Send(KindHookDistribution)
+		}
 		if e.Err != nil {
-			l.err().Err(e.Err).Msg("stop failed")
+			l.stopFields(l.err().Err(e.Err)).Send(KindStopFailed)
+		} else if l.resources || l.causes {
+			l.stopFields(l.log()).Send(KindStopped)
 		}
 	case *fxevent.RollingBack:
-		l.err().Err(e.StartErr).Msg("start failed, rolling back")
+		l.err().Err(e.StartErr).Send(KindRollingBack)
 	case *fxevent.RolledBack:
 		if e.Err != nil {
-			l.err().Err(e.Err).Msg("rollback failed")
+			l.causeField(l.err().Err(e.Err)).Send(KindRollbackFailed)
+		} else if l.causes {
+			l.causeField(l.log()).Send(KindRolledBack)
 		}
 	case *fxevent.Started:
+		if l.slowStart != nil {
+			l.slowStart.fields(l.log()).Send(KindSlowestStartHooks)
+		}
+		if l.timings != nil {
+			l.logStartTimings()
+		}
+		if l.timeline != nil {
+			l.logTimeline()
+		}
 		if e.Err != nil {
-			l.err().Err(e.Err).Msg("start failed")
+			event := l.err().Err(e.Err)
+			if l.resources {
+				event = resourceFields(event)
+			}
+			event.Send(KindStartFailed)
 		} else {
-			l.log().Msg("started")
+			if l.graphSummary {
+				l.startup.constructorFields(l.log()).Send(KindConstructorSummary)
+			}
+			l.exportGraph()
+			event := l.log()
+			if l.quiet {
+				event = l.startup.fields(event, l.clock.Now())
+			}
+			if l.resources {
+				event = resourceFields(event)
+			}
+			event.Send(KindStarted)
 		}
 	case *fxevent.LoggerInitialized:
 		if e.Err != nil {
-			l.err().Err(e.Err).Msg("custom logger initialization failed")
+			l.err().Err(e.Err).Send(KindLoggerInitFailed)
 		} else {
-			l.log().Str("function", e.ConstructorName).Msg("initialized custom fxevent.Logger")
+			l.log().Str("function", e.ConstructorName).Send(KindLoggerInitialized)
 		}
 	}
 }
 
-// moduleName adds the module name to the zerolog event if present.
-func moduleName(event *zerolog.Event, name string) *zerolog.Event {
+// traces adds the stack and module traces to the entry unless they are
+// disabled, or only enabled for failed events.
+func (l *Logger) traces(event *entry, stack, module []string) *entry {
+	if l.noTraces || (l.errorTraces && eventError(l.event) == nil) {
+		return event
+	}
+	return event.Strs("stacktrace", stack).Strs("moduletrace", module)
+}
+
+// stopFields adds the fields of the Stopped record: the resource snapshot
+// and shutdown cause, if enabled.
+func (l *Logger) stopFields(event *entry) *entry {
+	if l.resources {
+		event = resourceFields(event)
+	}
+	return l.causeField(event)
+}
+
+// moduleName adds the module name to the entry if present.
+func moduleName(event *entry, name string) *entry {
 	if len(name) == 0 {
 		return event
 	}
 	return event.Str("module", name)
 }
 
-// maybeBool adds a boolean field to the zerolog event if b is true.
-func maybeBool(event *zerolog.Event, name string, b bool) *zerolog.Event {
+// maybeBool adds a boolean field to the entry if b is true.
+func maybeBool(event *entry, name string, b bool) *entry {
 	if b {
 		return event.Bool(name, true)
 	}
 	return event
 }
+
+// isSuppressed reports whether name matches any WithSuppressedTypes pattern.
+func (l *Logger) isSuppressed(name string) bool {
+	for _, re := range l.suppressed {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFxInternal reports whether the named function belongs to the fx package
+// itself rather than to application code.
+func isFxInternal(name string) bool {
+	return strings.HasPrefix(name, "go.uber.org/fx.")
+}
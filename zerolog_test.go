@@ -9,6 +9,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"go.uber.org/fx/fxevent"
@@ -112,9 +113,8 @@ func TestLogger_LogEvent_AllEvents(t *testing.T) {
 }
 
 func TestLogger_ModuleNameAndMaybeBool(t *testing.T) {
-	buf := &bytes.Buffer{}
-	zl := zerolog.New(buf)
-	evt := zl.Info()
+	logger, buf := newTestLogger()
+	evt := logger.log()
 	evt = moduleName(evt, "mod1")
 	evt = maybeBool(evt, "private", true)
 	evt.Msg("test")
@@ -126,3 +126,61 @@ func TestLogger_ModuleNameAndMaybeBool(t *testing.T) {
 		t.Error("Expected private bool in log output")
 	}
 }
+
+func TestNewDiscard(t *testing.T) {
+	logger := NewDiscard()
+	logger.LogEvent(&fxevent.Started{})
+	if logger.Stats().Events["Started"] != 1 {
+		t.Errorf("Expected the event to be observed, got %v", logger.Stats().Events)
+	}
+}
+
+func TestLogger_DisabledLevelSkipsWork(t *testing.T) {
+	buf := &bytes.Buffer{}
+	zl := zerolog.New(buf).Level(zerolog.WarnLevel)
+	logger := New(&zl).(*Logger)
+	event := &fxevent.Provided{
+		ConstructorName: "main.NewServer()",
+		OutputTypeNames: []string{"*main.Server", "http.Handler"},
+		ModuleName:      "server",
+		StackTrace:      []string{"main.go:10"},
+		ModuleTrace:     []string{"main.go:5"},
+	}
+	logger.LogEvent(event)
+	allocs := testing.AllocsPerRun(100, func() { logger.LogEvent(event) })
+	if allocs != 0 {
+		t.Errorf("Expected no allocations for a disabled record, got %v", allocs)
+	}
+	if buf.Len() > 0 {
+		t.Errorf("Expected no output, got %s", buf.String())
+	}
+
+	logger.LogEvent(&fxevent.Invoked{FunctionName: "main.run()", Err: errors.New("boom")})
+	if !strings.Contains(buf.String(), "invoke failed") {
+		t.Errorf("Expected enabled records to still be written, got %s", buf.String())
+	}
+}
+
+func TestLogEvent_ZeroAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not stable with the race detector")
+	}
+	events := []fxevent.Event{
+		&fxevent.OnStartExecuting{FunctionName: "main.start", CallerName: "main.run"},
+		&fxevent.OnStartExecuted{FunctionName: "main.start", CallerName: "main.run", Runtime: time.Millisecond},
+		&fxevent.OnStopExecuting{FunctionName: "main.stop", CallerName: "main.run"},
+		&fxevent.OnStopExecuted{FunctionName: "main.stop", CallerName: "main.run", Runtime: time.Millisecond},
+		&fxevent.Provided{ConstructorName: "main.NewServer()", OutputTypeNames: []string{"*main.Server", "http.Handler"}, ModuleName: "server"},
+		&fxevent.Supplied{TypeName: "*main.Config"},
+		&fxevent.Run{Name: "main.NewServer()", Kind: "provide", Runtime: time.Millisecond},
+		&fxevent.Invoking{FunctionName: "main.run()"},
+		&fxevent.Started{},
+	}
+	logger := NewDiscard()
+	for _, event := range events {
+		logger.LogEvent(event)
+		if allocs := testing.AllocsPerRun(100, func() { logger.LogEvent(event) }); allocs != 0 {
+			t.Errorf("Expected no allocations for %T, got %v", event, allocs)
+		}
+	}
+}